@@ -11,6 +11,9 @@ type Account struct {
 	ScreenName string `json:"screen_name"`
 }
 
+// GetAccount returns the authenticated user from the v1.1 API
+//
+// Deprecated: use NewGetMeCall instead
 func (service *Service) GetAccount() (*Account, *errortools.Error) {
 	urlPath := "account/verify_credentials.json"
 
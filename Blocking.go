@@ -0,0 +1,212 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetBlockedUsersCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetBlockedUsersCall returns the users blocked by userID, which must be the authenticated user
+func (service *Service) NewGetBlockedUsersCall(userID string) *GetBlockedUsersCall {
+	return &GetBlockedUsersCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetBlockedUsersCall) SetExpansions(expansions ...UserExpansion) *GetBlockedUsersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetBlockedUsersCall) SetMaxResults(maxResults int) *GetBlockedUsersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetBlockedUsersCall) SetPaginationToken(paginationToken string) *GetBlockedUsersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetBlockedUsersCall) SetTweetFields(tweetFields ...TweetField) *GetBlockedUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetBlockedUsersCall) AddTweetFields(tweetFields ...TweetField) *GetBlockedUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetBlockedUsersCall) SetUserFields(userFields ...UserField) *GetBlockedUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetBlockedUsersCall) AddUserFields(userFields ...UserField) *GetBlockedUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetBlockedUsersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetBlockedUsersCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/blocking%s", call.userID, *params)
+
+		blockedUsersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &blockedUsersResponse,
+		}
+
+		endpoint := "users_blocking"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if blockedUsersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *blockedUsersResponse.Errors))
+		}
+
+		if blockedUsersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *blockedUsersResponse.Data...)
+		includes.Append(blockedUsersResponse.Includes)
+
+		if blockedUsersResponse.Meta == nil {
+			break
+		}
+
+		if blockedUsersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = blockedUsersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type BlockingResponse struct {
+	Data *struct {
+		Blocking bool `json:"blocking"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// BlockUser blocks targetUserID on behalf of sourceUserID, which must be the authenticated user
+func (service *Service) BlockUser(sourceUserID string, targetUserID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("BlockUser")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		TargetUserID string `json:"target_user_id"`
+	}{
+		TargetUserID: targetUserID,
+	}
+
+	blockingResponse := BlockingResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/blocking", sourceUserID)),
+		BodyModel:     body,
+		ResponseModel: &blockingResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if blockingResponse.Errors != nil {
+		return false, errorsFound(request, response, *blockingResponse.Errors)
+	}
+
+	if blockingResponse.Data == nil {
+		return false, nil
+	}
+
+	return blockingResponse.Data.Blocking, nil
+}
+
+// UnblockUser unblocks targetUserID on behalf of sourceUserID, which must be the authenticated user
+func (service *Service) UnblockUser(sourceUserID string, targetUserID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UnblockUser")
+	if e != nil {
+		return false, e
+	}
+
+	blockingResponse := BlockingResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/blocking/%s", sourceUserID, targetUserID)),
+		ResponseModel: &blockingResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if blockingResponse.Errors != nil {
+		return false, errorsFound(request, response, *blockingResponse.Errors)
+	}
+
+	if blockingResponse.Data == nil {
+		return false, nil
+	}
+
+	return blockingResponse.Data.Blocking, nil
+}
@@ -0,0 +1,254 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetBookmarksCall struct {
+	service         *Service2
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetBookmarksCall returns the tweets bookmarked by userID, which must be the authenticated user
+func (service *Service2) NewGetBookmarksCall(userID string) *GetBookmarksCall {
+	return &GetBookmarksCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetBookmarksCall) SetExpansions(expansions ...TweetExpansion) *GetBookmarksCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetBookmarksCall) SetMaxResults(maxResults int) *GetBookmarksCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetBookmarksCall) SetMediaFields(mediaFields ...MediaField) *GetBookmarksCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetBookmarksCall) AddMediaFields(mediaFields ...MediaField) *GetBookmarksCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetBookmarksCall) SetPaginationToken(paginationToken string) *GetBookmarksCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetBookmarksCall) SetPlaceFields(placeFields ...PlaceField) *GetBookmarksCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetBookmarksCall) AddPlaceFields(placeFields ...PlaceField) *GetBookmarksCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetBookmarksCall) SetPollFields(pollFields ...PollField) *GetBookmarksCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetBookmarksCall) AddPollFields(pollFields ...PollField) *GetBookmarksCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetBookmarksCall) SetTweetFields(tweetFields ...TweetField) *GetBookmarksCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetBookmarksCall) AddTweetFields(tweetFields ...TweetField) *GetBookmarksCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetBookmarksCall) SetUserFields(userFields ...UserField) *GetBookmarksCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetBookmarksCall) AddUserFields(userFields ...UserField) *GetBookmarksCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetBookmarksCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/bookmarks%s", call.userID, *params)
+
+		userTweetsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &userTweetsResponse,
+		}
+
+		endpoint := "users_bookmarks"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if userTweetsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *userTweetsResponse.Errors))
+		}
+
+		if userTweetsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *userTweetsResponse.Data...)
+		includes.Append(userTweetsResponse.Includes)
+
+		if userTweetsResponse.Meta == nil {
+			break
+		}
+
+		if userTweetsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = userTweetsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
+
+type BookmarkResponse struct {
+	Data *struct {
+		Bookmarked bool `json:"bookmarked"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// BookmarkTweet bookmarks tweetID on behalf of userID, which must be the authenticated user
+func (service *Service2) BookmarkTweet(userID string, tweetID string) (bool, *errortools.Error) {
+	var body = struct {
+		TweetID string `json:"tweet_id"`
+	}{
+		TweetID: tweetID,
+	}
+
+	bookmarkResponse := BookmarkResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/bookmarks", userID)),
+		BodyModel:     body,
+		ResponseModel: &bookmarkResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if bookmarkResponse.Errors != nil {
+		return false, errorsFound(request, response, *bookmarkResponse.Errors)
+	}
+
+	if bookmarkResponse.Data == nil {
+		return false, nil
+	}
+
+	return bookmarkResponse.Data.Bookmarked, nil
+}
+
+// RemoveBookmark removes the bookmark of tweetID by userID, which must be the authenticated user
+func (service *Service2) RemoveBookmark(userID string, tweetID string) (bool, *errortools.Error) {
+	bookmarkResponse := BookmarkResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/bookmarks/%s", userID, tweetID)),
+		ResponseModel: &bookmarkResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if bookmarkResponse.Errors != nil {
+		return false, errorsFound(request, response, *bookmarkResponse.Errors)
+	}
+
+	if bookmarkResponse.Data == nil {
+		return false, nil
+	}
+
+	return bookmarkResponse.Data.Bookmarked, nil
+}
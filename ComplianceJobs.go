@@ -0,0 +1,279 @@
+package twitter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+const defaultComplianceJobPollInterval time.Duration = 30 * time.Second
+
+type ComplianceJobResponse struct {
+	Data   *models.ComplianceJob `json:"data"`
+	Errors *[]models.Error       `json:"errors"`
+}
+
+type ComplianceJobsResponse struct {
+	Data   *[]models.ComplianceJob `json:"data"`
+	Errors *[]models.Error         `json:"errors"`
+}
+
+type CreateComplianceJobConfig struct {
+	Type      models.ComplianceJobType `json:"type"`
+	Name      *string                  `json:"name,omitempty"`
+	Resumable *bool                    `json:"resumable,omitempty"`
+}
+
+// CreateComplianceJob creates a compliance job, the ids to check must be uploaded to its UploadUrl
+func (service *Service) CreateComplianceJob(config *CreateComplianceJobConfig) (*models.ComplianceJob, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("CreateComplianceJobConfig must not be a nil pointer")
+	}
+
+	complianceJobResponse := ComplianceJobResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url("compliance/jobs"),
+		BodyModel:     config,
+		ResponseModel: &complianceJobResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if complianceJobResponse.Errors != nil {
+		return nil, errorsFound(request, response, *complianceJobResponse.Errors)
+	}
+
+	if complianceJobResponse.Data == nil {
+		return nil, errortools.ErrorMessage("Response does not contain the created compliance job")
+	}
+
+	return complianceJobResponse.Data, nil
+}
+
+// GetComplianceJob returns the compliance job with jobID
+func (service *Service) GetComplianceJob(jobID string) (*models.ComplianceJob, *errortools.Error) {
+	complianceJobResponse := ComplianceJobResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("compliance/jobs/%s", jobID)),
+		ResponseModel: &complianceJobResponse,
+	}
+
+	endpoint := "compliance_jobs_id"
+	service.rateLimitService.Check(endpoint)
+
+	request, response, e := service.get(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	service.rateLimitService.Set(endpoint, response)
+
+	if complianceJobResponse.Errors != nil {
+		return nil, errorsFound(request, response, *complianceJobResponse.Errors)
+	}
+
+	if complianceJobResponse.Data == nil {
+		return nil, errortools.ErrorMessagef("Compliance job %s not found", jobID)
+	}
+
+	return complianceJobResponse.Data, nil
+}
+
+type GetComplianceJobsCall struct {
+	service *Service
+	Type    string  `tw:"type"`
+	Status  *string `tw:"status"`
+}
+
+// NewGetComplianceJobsCall returns the compliance jobs of jobType
+func (service *Service) NewGetComplianceJobsCall(jobType models.ComplianceJobType) *GetComplianceJobsCall {
+	return &GetComplianceJobsCall{
+		service: service,
+		Type:    string(jobType),
+	}
+}
+
+func (call *GetComplianceJobsCall) SetStatus(status models.ComplianceJobStatus) *GetComplianceJobsCall {
+	s := string(status)
+	(*call).Status = &s
+
+	return call
+}
+
+func (call *GetComplianceJobsCall) Do() (*[]models.ComplianceJob, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, e
+	}
+
+	complianceJobsResponse := ComplianceJobsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(fmt.Sprintf("compliance/jobs%s", *params)),
+		ResponseModel: &complianceJobsResponse,
+	}
+
+	endpoint := "compliance_jobs"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	if complianceJobsResponse.Errors != nil {
+		return nil, errorsFound(request, response, *complianceJobsResponse.Errors)
+	}
+
+	if complianceJobsResponse.Data == nil {
+		return &[]models.ComplianceJob{}, nil
+	}
+
+	return complianceJobsResponse.Data, nil
+}
+
+// UploadComplianceJobIDs uploads the tweet or user ids to check to the UploadUrl of job
+func (service *Service) UploadComplianceJobIDs(job *models.ComplianceJob, ids []string) *errortools.Error {
+	if job == nil {
+		return errortools.ErrorMessage("ComplianceJob must not be a nil pointer")
+	}
+
+	if len(ids) == 0 {
+		return errortools.ErrorMessage("No ids specified")
+	}
+
+	// the upload url is pre-signed, so the request must not carry the credentials of the Service
+	httpService, e := go_http.NewService(&go_http.ServiceConfig{})
+	if e != nil {
+		return e
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain")
+
+	b := []byte(strings.Join(ids, "\n"))
+	requestConfig := go_http.RequestConfig{
+		Method:            http.MethodPut,
+		Url:               job.UploadUrl,
+		BodyRaw:           &b,
+		NonDefaultHeaders: &header,
+	}
+
+	_, _, e = httpService.HttpRequest(&requestConfig)
+
+	return e
+}
+
+// WaitForComplianceJob polls the status of the compliance job with jobID every interval until it is complete,
+// it returns an error if the job failed or expired
+func (service *Service) WaitForComplianceJob(jobID string, interval *time.Duration) (*models.ComplianceJob, *errortools.Error) {
+	pollInterval := defaultComplianceJobPollInterval
+	if interval != nil {
+		pollInterval = *interval
+	}
+
+	for {
+		job, e := service.GetComplianceJob(jobID)
+		if e != nil {
+			return nil, e
+		}
+
+		switch job.Status {
+		case models.ComplianceJobStatusComplete:
+			return job, nil
+		case models.ComplianceJobStatusFailed, models.ComplianceJobStatusExpired:
+			return job, errortools.ErrorMessagef("Compliance job %s %s", jobID, job.Status)
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// DownloadComplianceJobResult downloads the result of a completed compliance job
+func (service *Service) DownloadComplianceJobResult(job *models.ComplianceJob) (*[]models.ComplianceJobEvent, *errortools.Error) {
+	if job == nil {
+		return nil, errortools.ErrorMessage("ComplianceJob must not be a nil pointer")
+	}
+
+	if job.Status != models.ComplianceJobStatusComplete {
+		return nil, errortools.ErrorMessagef("Compliance job %s is not complete", job.ID)
+	}
+
+	// the download url is pre-signed, so the request must not carry the credentials of the Service
+	httpService, e := go_http.NewService(&go_http.ServiceConfig{})
+	if e != nil {
+		return nil, e
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodGet,
+		Url:    job.DownloadUrl,
+	}
+
+	_, response, e := httpService.HttpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+	defer response.Body.Close()
+
+	events := []models.ComplianceJobEvent{}
+
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		event := models.ComplianceJobEvent{}
+
+		err := json.Unmarshal(line, &event)
+		if err != nil {
+			return nil, errortools.ErrorMessage(err)
+		}
+
+		events = append(events, event)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, errortools.ErrorMessage(err)
+	}
+
+	return &events, nil
+}
+
+// RunComplianceJob creates a compliance job for ids, waits for it to complete and returns its result
+func (service *Service) RunComplianceJob(jobType models.ComplianceJobType, ids []string) (*[]models.ComplianceJobEvent, *errortools.Error) {
+	job, e := service.CreateComplianceJob(&CreateComplianceJobConfig{
+		Type: jobType,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	e = service.UploadComplianceJobIDs(job, ids)
+	if e != nil {
+		return nil, e
+	}
+
+	job, e = service.WaitForComplianceJob(job.ID, nil)
+	if e != nil {
+		return nil, e
+	}
+
+	return service.DownloadComplianceJobResult(job)
+}
@@ -0,0 +1,191 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type ComplianceStreamResponse struct {
+	Data   *map[string]json.RawMessage `json:"data"`
+	Errors *[]models.Error             `json:"errors"`
+}
+
+// ComplianceEventHandler handles the events received from a compliance stream
+type ComplianceEventHandler interface {
+	HandleComplianceEvent(event *models.ComplianceEvent)
+}
+
+// ComplianceEventHandlerFunc allows an ordinary function to be used as ComplianceEventHandler
+type ComplianceEventHandlerFunc func(event *models.ComplianceEvent)
+
+func (f ComplianceEventHandlerFunc) HandleComplianceEvent(event *models.ComplianceEvent) {
+	f(event)
+}
+
+type GetComplianceStreamCall struct {
+	streamer
+	service         *Service
+	path            string
+	BackfillMinutes *int       `tw:"backfill_minutes"`
+	EndTime         *time.Time `tw:"end_time"`
+	Partition       *int       `tw:"partition"`
+	StartTime       *time.Time `tw:"start_time"`
+}
+
+// NewGetTweetComplianceStreamCall streams the compliance events of tweets in partition
+func (service *Service) NewGetTweetComplianceStreamCall(partition int) *GetComplianceStreamCall {
+	return &GetComplianceStreamCall{
+		service:   service,
+		path:      "tweets/compliance/stream",
+		Partition: &partition,
+	}
+}
+
+// NewGetUserComplianceStreamCall streams the compliance events of users in partition
+func (service *Service) NewGetUserComplianceStreamCall(partition int) *GetComplianceStreamCall {
+	return &GetComplianceStreamCall{
+		service:   service,
+		path:      "users/compliance/stream",
+		Partition: &partition,
+	}
+}
+
+// NewGetLikeComplianceStreamCall streams the compliance events of likes in partition
+func (service *Service) NewGetLikeComplianceStreamCall(partition int) *GetComplianceStreamCall {
+	return &GetComplianceStreamCall{
+		service:   service,
+		path:      "likes/compliance/stream",
+		Partition: &partition,
+	}
+}
+
+// NewGetLabelStreamCall streams the labels applied to and removed from tweets
+func (service *Service) NewGetLabelStreamCall() *GetComplianceStreamCall {
+	return &GetComplianceStreamCall{
+		service: service,
+		path:    "tweets/label/stream",
+	}
+}
+
+// SetBackfillMinutes requests up to five minutes of events missed during a disconnect to be redelivered on reconnect,
+// it is ignored if a start time is set, in which case the stream resumes from the last event received
+func (call *GetComplianceStreamCall) SetBackfillMinutes(backfillMinutes int) *GetComplianceStreamCall {
+	(*call).BackfillMinutes = &backfillMinutes
+
+	return call
+}
+
+func (call *GetComplianceStreamCall) SetEndTime(endTime time.Time) *GetComplianceStreamCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+// SetStartTime replays the events since startTime, to catch up after a longer period of downtime
+func (call *GetComplianceStreamCall) SetStartTime(startTime time.Time) *GetComplianceStreamCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+// Do consumes the stream and passes every event to handler, it blocks until Stop is called
+func (call *GetComplianceStreamCall) Do(handler ComplianceEventHandler) *errortools.Error {
+	if handler == nil {
+		return errortools.ErrorMessage("ComplianceEventHandler must not be nil")
+	}
+
+	startTime := call.StartTime
+	backfillMinutes := call.BackfillMinutes
+	defer func() {
+		call.StartTime = startTime
+		call.BackfillMinutes = backfillMinutes
+	}()
+
+	// lastEventAt holds the time of the last event passed to handler and lastEvents the events passed at that time,
+	// after a reconnect these are redelivered and skipped until the stream has caught up
+	var lastEventAt *time.Time = nil
+	var lastEvents map[string]bool = nil
+	var skipUntil *time.Time = nil
+
+	url := func(reconnect bool) (string, *errortools.Error) {
+		call.StartTime = startTime
+		call.BackfillMinutes = nil
+
+		if reconnect {
+			skipUntil = lastEventAt
+
+			if startTime != nil {
+				// resume from the last event instead of replaying everything since the original start time
+				if lastEventAt != nil && lastEventAt.After(*startTime) {
+					call.StartTime = lastEventAt
+				}
+			} else {
+				// backfill only applies to the events missed during a disconnect
+				call.BackfillMinutes = backfillMinutes
+			}
+		}
+
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return "", e
+		}
+
+		return call.service.url(fmt.Sprintf("%s%s", call.path, *params)), nil
+	}
+
+	return call.service.stream(url, &call.streamer, func(line []byte) {
+		complianceStreamResponse := ComplianceStreamResponse{}
+
+		err := json.Unmarshal(line, &complianceStreamResponse)
+		if err != nil {
+			errortools.CaptureError(err)
+			return
+		}
+
+		if complianceStreamResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(nil, nil, *complianceStreamResponse.Errors))
+		}
+
+		if complianceStreamResponse.Data == nil {
+			return
+		}
+
+		for eventType, data := range *complianceStreamResponse.Data {
+			event := models.ComplianceEvent{}
+
+			err := json.Unmarshal(data, &event)
+			if err != nil {
+				errortools.CaptureError(err)
+				continue
+			}
+
+			event.Type = models.ComplianceEventType(eventType)
+
+			eventAt, err := event.EventAtTime()
+			if err == nil {
+				key := eventType + string(data)
+
+				if skipUntil != nil {
+					if eventAt.Before(*skipUntil) || (eventAt.Equal(*skipUntil) && lastEvents[key]) {
+						continue
+					}
+					if eventAt.After(*skipUntil) {
+						skipUntil = nil
+					}
+				}
+
+				if lastEventAt == nil || !eventAt.Equal(*lastEventAt) {
+					lastEvents = make(map[string]bool)
+				}
+				lastEventAt = eventAt
+				lastEvents[key] = true
+			}
+
+			handler.HandleComplianceEvent(&event)
+		}
+	})
+}
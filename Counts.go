@@ -0,0 +1,160 @@
+package twitter
+
+import (
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type TweetCountsResponse struct {
+	Data   *[]models.TweetCount `json:"data"`
+	Meta   *models.Meta         `json:"meta"`
+	Errors *[]models.Error      `json:"errors"`
+}
+
+type Granularity string
+
+const (
+	GranularityMinute Granularity = "minute"
+	GranularityHour   Granularity = "hour"
+	GranularityDay    Granularity = "day"
+)
+
+type GetTweetCountsCall struct {
+	service     *Service
+	all         bool
+	Query       string     `tw:"query"`
+	EndTime     *time.Time `tw:"end_time"`
+	Granularity *string    `tw:"granularity"`
+	NextToken   *string    `tw:"next_token"`
+	SinceID     *string    `tw:"since_id"`
+	StartTime   *time.Time `tw:"start_time"`
+	UntilID     *string    `tw:"until_id"`
+}
+
+// NewGetTweetCountsRecentCall counts the tweets matching query over the last seven days
+func (service *Service) NewGetTweetCountsRecentCall(query string) *GetTweetCountsCall {
+	return &GetTweetCountsCall{
+		service: service,
+		Query:   query,
+	}
+}
+
+// NewGetTweetCountsAllCall counts the tweets matching query over the full archive
+func (service *Service) NewGetTweetCountsAllCall(query string) *GetTweetCountsCall {
+	return &GetTweetCountsCall{
+		service: service,
+		all:     true,
+		Query:   query,
+	}
+}
+
+func (call *GetTweetCountsCall) SetEndTime(endTime time.Time) *GetTweetCountsCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+func (call *GetTweetCountsCall) SetGranularity(granularity Granularity) *GetTweetCountsCall {
+	_granularity := string(granularity)
+	(*call).Granularity = &_granularity
+
+	return call
+}
+
+func (call *GetTweetCountsCall) SetNextToken(nextToken string) *GetTweetCountsCall {
+	(*call).NextToken = &nextToken
+
+	return call
+}
+
+func (call *GetTweetCountsCall) SetSinceID(sinceID string) *GetTweetCountsCall {
+	(*call).SinceID = &sinceID
+
+	return call
+}
+
+func (call *GetTweetCountsCall) SetStartTime(startTime time.Time) *GetTweetCountsCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+func (call *GetTweetCountsCall) SetUntilID(untilID string) *GetTweetCountsCall {
+	(*call).UntilID = &untilID
+
+	return call
+}
+
+// Do returns the count buckets of all pages and the sum of their total_tweet_count
+func (call *GetTweetCountsCall) Do() (*[]models.TweetCount, int64, *errortools.Error) {
+	if call.Query == "" {
+		return nil, 0, errortools.ErrorMessage("No Query specified")
+	}
+
+	tweetCounts := []models.TweetCount{}
+	var totalTweetCount int64 = 0
+
+	path := "tweets/counts/recent"
+	endpoint := "tweets_counts_recent"
+	if call.all {
+		path = "tweets/counts/all"
+		endpoint = "tweets_counts_all"
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, 0, e
+		}
+
+		urlPath := fmt.Sprintf("%s%s", path, *params)
+
+		tweetCountsResponse := TweetCountsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &tweetCountsResponse,
+		}
+
+		call.service.rateLimitService.Check(endpoint)
+		if call.all {
+			call.service.pace(endpoint, searchAllTweetsInterval)
+		}
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, 0, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if tweetCountsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *tweetCountsResponse.Errors))
+		}
+
+		if tweetCountsResponse.Data == nil {
+			break
+		}
+
+		tweetCounts = append(tweetCounts, *tweetCountsResponse.Data...)
+
+		if tweetCountsResponse.Meta == nil {
+			break
+		}
+
+		if tweetCountsResponse.Meta.TotalTweetCount != nil {
+			totalTweetCount += *tweetCountsResponse.Meta.TotalTweetCount
+		}
+
+		if tweetCountsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.NextToken = tweetCountsResponse.Meta.NextToken
+	}
+
+	return &tweetCounts, totalTweetCount, nil
+}
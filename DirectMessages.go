@@ -0,0 +1,369 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type DMEventsResponse struct {
+	Data     *[]models.DMEvent `json:"data"`
+	Includes *models.Includes  `json:"includes"`
+	Meta     *models.Meta      `json:"meta"`
+	Errors   *[]models.Error   `json:"errors"`
+}
+
+type DMEventExpansion string
+
+const (
+	DMEventExpansionAttachmentsMediaKeys DMEventExpansion = "attachments.media_keys"
+	DMEventExpansionParticipantIDs       DMEventExpansion = "participant_ids"
+	DMEventExpansionReferencedTweetsID   DMEventExpansion = "referenced_tweets.id"
+	DMEventExpansionSenderID             DMEventExpansion = "sender_id"
+)
+
+type DMEventField string
+
+const (
+	DMEventFieldAttachments      DMEventField = "attachments"
+	DMEventFieldCreatedAt        DMEventField = "created_at"
+	DMEventFieldDMConversationID DMEventField = "dm_conversation_id"
+	DMEventFieldEventType        DMEventField = "event_type"
+	DMEventFieldID               DMEventField = "id"
+	DMEventFieldParticipantIDs   DMEventField = "participant_ids"
+	DMEventFieldReferencedTweets DMEventField = "referenced_tweets"
+	DMEventFieldSenderID         DMEventField = "sender_id"
+	DMEventFieldText             DMEventField = "text"
+)
+
+type DMEventType string
+
+const (
+	DMEventTypeMessageCreate     DMEventType = "MessageCreate"
+	DMEventTypeParticipantsJoin  DMEventType = "ParticipantsJoin"
+	DMEventTypeParticipantsLeave DMEventType = "ParticipantsLeave"
+)
+
+func setDMEventFields(add bool, dmEventFields *[]string, setDMEventFields []DMEventField) {
+	elems := []string{}
+
+	if dmEventFields != nil && add {
+		elems = *dmEventFields
+	}
+
+	for _, dmEventField := range setDMEventFields {
+		for _, _elem := range elems {
+			if _elem == string(dmEventField) {
+				goto next
+			}
+		}
+		elems = append(elems, string(dmEventField))
+	next:
+	}
+	(*dmEventFields) = elems
+}
+
+type GetDMEventsCall struct {
+	service         *Service
+	path            string
+	endpoint        string
+	DMEventFields   *[]string `tw:"dm_event.fields"`
+	EventTypes      *[]string `tw:"event_types"`
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetDMEventsCall returns the direct message events of all conversations of the authenticated user
+func (service *Service) NewGetDMEventsCall() *GetDMEventsCall {
+	return &GetDMEventsCall{
+		service:  service,
+		path:     "dm_events",
+		endpoint: "dm_events",
+	}
+}
+
+// NewGetDMConversationEventsCall returns the direct message events of conversationID
+func (service *Service) NewGetDMConversationEventsCall(conversationID string) *GetDMEventsCall {
+	return &GetDMEventsCall{
+		service:  service,
+		path:     fmt.Sprintf("dm_conversations/%s/dm_events", conversationID),
+		endpoint: "dm_conversations_dm_events",
+	}
+}
+
+// NewGetDMParticipantEventsCall returns the direct message events of the one-to-one conversation with participantID
+func (service *Service) NewGetDMParticipantEventsCall(participantID string) *GetDMEventsCall {
+	return &GetDMEventsCall{
+		service:  service,
+		path:     fmt.Sprintf("dm_conversations/with/%s/dm_events", participantID),
+		endpoint: "dm_conversations_with_dm_events",
+	}
+}
+
+func (call *GetDMEventsCall) SetDMEventFields(dmEventFields ...DMEventField) *GetDMEventsCall {
+	if call.DMEventFields == nil {
+		call.DMEventFields = &[]string{}
+	}
+	setDMEventFields(false, call.DMEventFields, dmEventFields)
+	return call
+}
+
+func (call *GetDMEventsCall) AddDMEventFields(dmEventFields ...DMEventField) *GetDMEventsCall {
+	if call.DMEventFields == nil {
+		call.DMEventFields = &[]string{}
+	}
+	setDMEventFields(true, call.DMEventFields, dmEventFields)
+	return call
+}
+
+func (call *GetDMEventsCall) SetEventTypes(eventTypes ...DMEventType) *GetDMEventsCall {
+	elems := []string{}
+
+	for _, elem := range eventTypes {
+		elems = append(elems, string(elem))
+	}
+	(*call).EventTypes = &elems
+
+	return call
+}
+
+func (call *GetDMEventsCall) SetExpansions(expansions ...DMEventExpansion) *GetDMEventsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetDMEventsCall) SetMaxResults(maxResults int) *GetDMEventsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetDMEventsCall) SetMediaFields(mediaFields ...MediaField) *GetDMEventsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetDMEventsCall) AddMediaFields(mediaFields ...MediaField) *GetDMEventsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetDMEventsCall) SetPaginationToken(paginationToken string) *GetDMEventsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetDMEventsCall) SetTweetFields(tweetFields ...TweetField) *GetDMEventsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetDMEventsCall) AddTweetFields(tweetFields ...TweetField) *GetDMEventsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetDMEventsCall) SetUserFields(userFields ...UserField) *GetDMEventsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetDMEventsCall) AddUserFields(userFields ...UserField) *GetDMEventsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetDMEventsCall) Do() (*[]models.DMEvent, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetDMEventsCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	dmEvents := []models.DMEvent{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("%s%s", call.path, *params)
+
+		dmEventsResponse := DMEventsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &dmEventsResponse,
+		}
+
+		endpoint := call.endpoint
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if dmEventsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *dmEventsResponse.Errors))
+		}
+
+		if dmEventsResponse.Data == nil {
+			break
+		}
+
+		dmEvents = append(dmEvents, *dmEventsResponse.Data...)
+		includes.Append(dmEventsResponse.Includes)
+
+		if dmEventsResponse.Meta == nil {
+			break
+		}
+
+		if dmEventsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = dmEventsResponse.Meta.NextToken
+	}
+
+	return &dmEvents, &includes, nil
+}
+
+type DMAttachment struct {
+	MediaID string `json:"media_id"`
+}
+
+type DMMessage struct {
+	Text        *string         `json:"text,omitempty"`
+	Attachments *[]DMAttachment `json:"attachments,omitempty"`
+}
+
+type SendDMConfig struct {
+	// either ParticipantID or ConversationID must be set
+	ParticipantID  *string
+	ConversationID *string
+	Message        DMMessage
+}
+
+type SentDM struct {
+	DMConversationID string `json:"dm_conversation_id"`
+	DMEventID        string `json:"dm_event_id"`
+}
+
+type SentDMResponse struct {
+	Data   *SentDM         `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// SendDM sends a direct message to a participant or to an existing conversation
+func (service *Service) SendDM(config *SendDMConfig) (*SentDM, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("SendDMConfig must not be a nil pointer")
+	}
+
+	var urlPath string
+	if config.ParticipantID != nil && config.ConversationID == nil {
+		urlPath = fmt.Sprintf("dm_conversations/with/%s/messages", *config.ParticipantID)
+	} else if config.ConversationID != nil && config.ParticipantID == nil {
+		urlPath = fmt.Sprintf("dm_conversations/%s/messages", *config.ConversationID)
+	} else {
+		return nil, errortools.ErrorMessage("Either ParticipantID or ConversationID must be specified")
+	}
+
+	e := service.requireUserContext("SendDM")
+	if e != nil {
+		return nil, e
+	}
+
+	return service.postDM(urlPath, config.Message)
+}
+
+type CreateGroupConversationConfig struct {
+	ParticipantIDs []string
+	Message        DMMessage
+}
+
+// CreateGroupConversation creates a group conversation with ParticipantIDs and sends the first message to it
+func (service *Service) CreateGroupConversation(config *CreateGroupConversationConfig) (*SentDM, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("CreateGroupConversationConfig must not be a nil pointer")
+	}
+
+	if len(config.ParticipantIDs) == 0 {
+		return nil, errortools.ErrorMessage("No ParticipantIDs specified")
+	}
+
+	e := service.requireUserContext("CreateGroupConversation")
+	if e != nil {
+		return nil, e
+	}
+
+	var body = struct {
+		ConversationType string    `json:"conversation_type"`
+		ParticipantIDs   []string  `json:"participant_ids"`
+		Message          DMMessage `json:"message"`
+	}{
+		ConversationType: "Group",
+		ParticipantIDs:   config.ParticipantIDs,
+		Message:          config.Message,
+	}
+
+	return service.postDM("dm_conversations", body)
+}
+
+func (service *Service) postDM(urlPath string, body interface{}) (*SentDM, *errortools.Error) {
+	sentDMResponse := SentDMResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(urlPath),
+		BodyModel:     body,
+		ResponseModel: &sentDMResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if sentDMResponse.Errors != nil {
+		return nil, errorsFound(request, response, *sentDMResponse.Errors)
+	}
+
+	if sentDMResponse.Data == nil {
+		return nil, errortools.ErrorMessage("Response does not contain the sent direct message")
+	}
+
+	return sentDMResponse.Data, nil
+}
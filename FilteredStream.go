@@ -0,0 +1,284 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type FilteredStreamResponse struct {
+	Data          *models.Tweet          `json:"data"`
+	Includes      *models.Includes       `json:"includes"`
+	MatchingRules *[]models.MatchingRule `json:"matching_rules"`
+	Errors        *[]models.Error        `json:"errors"`
+}
+
+type GetFilteredStreamCall struct {
+	streamer
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	MediaFields *[]string `tw:"media.fields"`
+	PlaceFields *[]string `tw:"place.fields"`
+	PollFields  *[]string `tw:"poll.fields"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetFilteredStreamCall() *GetFilteredStreamCall {
+	return &GetFilteredStreamCall{
+		service: service,
+	}
+}
+
+func (call *GetFilteredStreamCall) SetExpansions(expansions ...TweetExpansion) *GetFilteredStreamCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetFilteredStreamCall) SetMediaFields(mediaFields ...MediaField) *GetFilteredStreamCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) AddMediaFields(mediaFields ...MediaField) *GetFilteredStreamCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) SetPlaceFields(placeFields ...PlaceField) *GetFilteredStreamCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) AddPlaceFields(placeFields ...PlaceField) *GetFilteredStreamCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) SetPollFields(pollFields ...PollField) *GetFilteredStreamCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) AddPollFields(pollFields ...PollField) *GetFilteredStreamCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) SetTweetFields(tweetFields ...TweetField) *GetFilteredStreamCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) AddTweetFields(tweetFields ...TweetField) *GetFilteredStreamCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) SetUserFields(userFields ...UserField) *GetFilteredStreamCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetFilteredStreamCall) AddUserFields(userFields ...UserField) *GetFilteredStreamCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+// Do consumes the stream and passes every tweet to callback, it blocks until Stop is called
+func (call *GetFilteredStreamCall) Do(callback func(response *FilteredStreamResponse)) *errortools.Error {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return e
+	}
+
+	urlPath := fmt.Sprintf("tweets/search/stream%s", *params)
+
+	return call.service.stream(fixedStreamUrl(call.service.url(urlPath)), &call.streamer, func(line []byte) {
+		filteredStreamResponse := FilteredStreamResponse{}
+
+		err := json.Unmarshal(line, &filteredStreamResponse)
+		if err != nil {
+			errortools.CaptureError(err)
+			return
+		}
+
+		callback(&filteredStreamResponse)
+	})
+}
+
+type StreamRulesResponse struct {
+	Data   *[]models.StreamRule `json:"data"`
+	Meta   *StreamRulesMeta     `json:"meta"`
+	Errors *[]models.Error      `json:"errors"`
+}
+
+type StreamRulesMeta struct {
+	Sent        string              `json:"sent"`
+	ResultCount int                 `json:"result_count"`
+	Summary     *StreamRulesSummary `json:"summary"`
+}
+
+type StreamRulesSummary struct {
+	Created    int `json:"created"`
+	NotCreated int `json:"not_created"`
+	Valid      int `json:"valid"`
+	Invalid    int `json:"invalid"`
+	Deleted    int `json:"deleted"`
+	NotDeleted int `json:"not_deleted"`
+}
+
+type StreamRuleConfig struct {
+	Value string  `json:"value"`
+	Tag   *string `json:"tag,omitempty"`
+}
+
+// GetStreamRules returns the active filtered stream rules, optionally limited to ids
+func (service *Service) GetStreamRules(ids ...string) (*[]models.StreamRule, *errortools.Error) {
+	urlPath := "tweets/search/stream/rules"
+	if len(ids) > 0 {
+		urlPath = fmt.Sprintf("%s?ids=%s", urlPath, strings.Join(ids, ","))
+	}
+
+	streamRulesResponse := StreamRulesResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(urlPath),
+		ResponseModel: &streamRulesResponse,
+	}
+
+	request, response, e := service.get(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if streamRulesResponse.Errors != nil {
+		return nil, errorsFound(request, response, *streamRulesResponse.Errors)
+	}
+
+	if streamRulesResponse.Data == nil {
+		return &[]models.StreamRule{}, nil
+	}
+
+	return streamRulesResponse.Data, nil
+}
+
+// AddStreamRules adds rules to the filtered stream, with dryRun the rules are only validated,
+// besides the created rules it returns the errors of the rules that are invalid
+func (service *Service) AddStreamRules(rules []StreamRuleConfig, dryRun bool) (*[]models.StreamRule, *StreamRulesSummary, *[]models.Error, *errortools.Error) {
+	if len(rules) == 0 {
+		return nil, nil, nil, errortools.ErrorMessage("No rules specified")
+	}
+
+	var body = struct {
+		Add []StreamRuleConfig `json:"add"`
+	}{
+		Add: rules,
+	}
+
+	streamRulesResponse, e := service.postStreamRules(body, dryRun)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+
+	var summary *StreamRulesSummary = nil
+	if streamRulesResponse.Meta != nil {
+		summary = streamRulesResponse.Meta.Summary
+	}
+
+	return streamRulesResponse.Data, summary, streamRulesResponse.Errors, nil
+}
+
+// DeleteStreamRules deletes filtered stream rules by id and/or by value, with dryRun nothing is deleted,
+// besides the summary it returns the errors of the rules that could not be deleted
+func (service *Service) DeleteStreamRules(ids []string, values []string, dryRun bool) (*StreamRulesSummary, *[]models.Error, *errortools.Error) {
+	if len(ids) == 0 && len(values) == 0 {
+		return nil, nil, errortools.ErrorMessage("No rule ids or values specified")
+	}
+
+	type deleteRules struct {
+		IDs    []string `json:"ids,omitempty"`
+		Values []string `json:"values,omitempty"`
+	}
+
+	var body = struct {
+		Delete deleteRules `json:"delete"`
+	}{
+		Delete: deleteRules{
+			IDs:    ids,
+			Values: values,
+		},
+	}
+
+	streamRulesResponse, e := service.postStreamRules(body, dryRun)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if streamRulesResponse.Meta == nil {
+		return nil, streamRulesResponse.Errors, nil
+	}
+
+	return streamRulesResponse.Meta.Summary, streamRulesResponse.Errors, nil
+}
+
+func (service *Service) postStreamRules(body interface{}, dryRun bool) (*StreamRulesResponse, *errortools.Error) {
+	urlPath := "tweets/search/stream/rules"
+	if dryRun {
+		urlPath = fmt.Sprintf("%s?dry_run=true", urlPath)
+	}
+
+	streamRulesResponse := StreamRulesResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(urlPath),
+		BodyModel:     body,
+		ResponseModel: &streamRulesResponse,
+	}
+
+	// errors in the response refer to individual rules and are returned alongside the rules that succeeded
+	_, _, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &streamRulesResponse, nil
+}
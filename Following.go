@@ -0,0 +1,208 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetFollowingCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetFollowingCall(userID string) *GetFollowingCall {
+	return &GetFollowingCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetFollowingCall) SetExpansions(expansions ...UserExpansion) *GetFollowingCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetFollowingCall) SetMaxResults(maxResults int) *GetFollowingCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetFollowingCall) SetPaginationToken(paginationToken string) *GetFollowingCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetFollowingCall) SetTweetFields(tweetFields ...TweetField) *GetFollowingCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetFollowingCall) AddTweetFields(tweetFields ...TweetField) *GetFollowingCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetFollowingCall) SetUserFields(userFields ...UserField) *GetFollowingCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetFollowingCall) AddUserFields(userFields ...UserField) *GetFollowingCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetFollowingCall) Do() (*[]models.User, *errortools.Error) {
+	users := []models.User{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/following%s", call.userID, *params)
+
+		followingResponse := FollowersResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &followingResponse,
+		}
+
+		endpoint := "following"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if followingResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *followingResponse.Errors))
+		}
+
+		if followingResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *followingResponse.Data...)
+
+		if followingResponse.Meta == nil {
+			break
+		}
+
+		if followingResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = followingResponse.Meta.NextToken
+	}
+
+	return &users, nil
+}
+
+type FollowingStatus struct {
+	Following     bool `json:"following"`
+	PendingFollow bool `json:"pending_follow"`
+}
+
+type FollowingStatusResponse struct {
+	Data   *FollowingStatus `json:"data"`
+	Errors *[]models.Error  `json:"errors"`
+}
+
+// FollowUser lets sourceUserID, which must be the authenticated user, follow targetUserID,
+// for protected accounts the follow stays pending until approved
+func (service *Service) FollowUser(sourceUserID string, targetUserID string) (*FollowingStatus, *errortools.Error) {
+	e := service.requireUserContext("FollowUser")
+	if e != nil {
+		return nil, e
+	}
+
+	var body = struct {
+		TargetUserID string `json:"target_user_id"`
+	}{
+		TargetUserID: targetUserID,
+	}
+
+	followingStatusResponse := FollowingStatusResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/following", sourceUserID)),
+		BodyModel:     body,
+		ResponseModel: &followingStatusResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if followingStatusResponse.Errors != nil {
+		return nil, errorsFound(request, response, *followingStatusResponse.Errors)
+	}
+
+	if followingStatusResponse.Data == nil {
+		return &FollowingStatus{}, nil
+	}
+
+	return followingStatusResponse.Data, nil
+}
+
+// UnfollowUser lets sourceUserID, which must be the authenticated user, unfollow targetUserID
+func (service *Service) UnfollowUser(sourceUserID string, targetUserID string) (*FollowingStatus, *errortools.Error) {
+	e := service.requireUserContext("UnfollowUser")
+	if e != nil {
+		return nil, e
+	}
+
+	followingStatusResponse := FollowingStatusResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/following/%s", sourceUserID, targetUserID)),
+		ResponseModel: &followingStatusResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if followingStatusResponse.Errors != nil {
+		return nil, errorsFound(request, response, *followingStatusResponse.Errors)
+	}
+
+	if followingStatusResponse.Data == nil {
+		return &FollowingStatus{}, nil
+	}
+
+	return followingStatusResponse.Data, nil
+}
@@ -0,0 +1,238 @@
+package twitter
+
+import (
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetHomeTimelineCall struct {
+	service         *Service
+	userID          string
+	EndTime         *time.Time `tw:"end_time"`
+	Exclude         *[]string  `tw:"exclude"`
+	Expansions      *[]string  `tw:"expansions"`
+	MaxResults      *int       `tw:"max_results"`
+	MediaFields     *[]string  `tw:"media.fields"`
+	PaginationToken *string    `tw:"pagination_token"`
+	PlaceFields     *[]string  `tw:"place.fields"`
+	PollFields      *[]string  `tw:"poll.fields"`
+	SinceID         *string    `tw:"since_id"`
+	StartTime       *time.Time `tw:"start_time"`
+	TweetFields     *[]string  `tw:"tweet.fields"`
+	UntilID         *string    `tw:"until_id"`
+	UserFields      *[]string  `tw:"user.fields"`
+}
+
+// NewGetHomeTimelineCall returns the reverse chronological home timeline of userID, which must be the authenticated user
+func (service *Service) NewGetHomeTimelineCall(userID string) *GetHomeTimelineCall {
+	return &GetHomeTimelineCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetHomeTimelineCall) SetEndTime(endTime time.Time) *GetHomeTimelineCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetExclude(excludes ...Exclude) *GetHomeTimelineCall {
+	elems := []string{}
+
+	for _, elem := range excludes {
+		elems = append(elems, string(elem))
+	}
+	(*call).Exclude = &elems
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetExpansions(expansions ...TweetExpansion) *GetHomeTimelineCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetMaxResults(maxResults int) *GetHomeTimelineCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetMediaFields(mediaFields ...MediaField) *GetHomeTimelineCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) AddMediaFields(mediaFields ...MediaField) *GetHomeTimelineCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetPaginationToken(paginationToken string) *GetHomeTimelineCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetPlaceFields(placeFields ...PlaceField) *GetHomeTimelineCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) AddPlaceFields(placeFields ...PlaceField) *GetHomeTimelineCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetPollFields(pollFields ...PollField) *GetHomeTimelineCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) AddPollFields(pollFields ...PollField) *GetHomeTimelineCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetSinceID(sinceID string) *GetHomeTimelineCall {
+	(*call).SinceID = &sinceID
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetStartTime(startTime time.Time) *GetHomeTimelineCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetTweetFields(tweetFields ...TweetField) *GetHomeTimelineCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) AddTweetFields(tweetFields ...TweetField) *GetHomeTimelineCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetUntilID(untilID string) *GetHomeTimelineCall {
+	(*call).UntilID = &untilID
+
+	return call
+}
+
+func (call *GetHomeTimelineCall) SetUserFields(userFields ...UserField) *GetHomeTimelineCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) AddUserFields(userFields ...UserField) *GetHomeTimelineCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetHomeTimelineCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetHomeTimelineCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/timelines/reverse_chronological%s", call.userID, *params)
+
+		homeTimelineResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &homeTimelineResponse,
+		}
+
+		endpoint := "users_timelines_reverse_chronological"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if homeTimelineResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *homeTimelineResponse.Errors))
+		}
+
+		if homeTimelineResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *homeTimelineResponse.Data...)
+		includes.Append(homeTimelineResponse.Includes)
+
+		if homeTimelineResponse.Meta == nil {
+			break
+		}
+
+		if homeTimelineResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = homeTimelineResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
@@ -0,0 +1,388 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetLikingUsersCall struct {
+	service         *Service
+	tweetID         string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetLikingUsersCall(tweetID string) *GetLikingUsersCall {
+	return &GetLikingUsersCall{
+		service: service,
+		tweetID: tweetID,
+	}
+}
+
+func (call *GetLikingUsersCall) SetExpansions(expansions ...UserExpansion) *GetLikingUsersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetLikingUsersCall) SetMaxResults(maxResults int) *GetLikingUsersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetLikingUsersCall) SetPaginationToken(paginationToken string) *GetLikingUsersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetLikingUsersCall) SetTweetFields(tweetFields ...TweetField) *GetLikingUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetLikingUsersCall) AddTweetFields(tweetFields ...TweetField) *GetLikingUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetLikingUsersCall) SetUserFields(userFields ...UserField) *GetLikingUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetLikingUsersCall) AddUserFields(userFields ...UserField) *GetLikingUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetLikingUsersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/%s/liking_users%s", call.tweetID, *params)
+
+		likingUsersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &likingUsersResponse,
+		}
+
+		endpoint := "tweets_liking_users"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if likingUsersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *likingUsersResponse.Errors))
+		}
+
+		if likingUsersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *likingUsersResponse.Data...)
+		includes.Append(likingUsersResponse.Includes)
+
+		if likingUsersResponse.Meta == nil {
+			break
+		}
+
+		if likingUsersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = likingUsersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type GetLikedTweetsCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetLikedTweetsCall(userID string) *GetLikedTweetsCall {
+	return &GetLikedTweetsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetLikedTweetsCall) SetExpansions(expansions ...TweetExpansion) *GetLikedTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetMaxResults(maxResults int) *GetLikedTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetMediaFields(mediaFields ...MediaField) *GetLikedTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) AddMediaFields(mediaFields ...MediaField) *GetLikedTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetPaginationToken(paginationToken string) *GetLikedTweetsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetPlaceFields(placeFields ...PlaceField) *GetLikedTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) AddPlaceFields(placeFields ...PlaceField) *GetLikedTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetPollFields(pollFields ...PollField) *GetLikedTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) AddPollFields(pollFields ...PollField) *GetLikedTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetTweetFields(tweetFields ...TweetField) *GetLikedTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) AddTweetFields(tweetFields ...TweetField) *GetLikedTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) SetUserFields(userFields ...UserField) *GetLikedTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) AddUserFields(userFields ...UserField) *GetLikedTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetLikedTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/liked_tweets%s", call.userID, *params)
+
+		likedTweetsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &likedTweetsResponse,
+		}
+
+		endpoint := "users_liked_tweets"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if likedTweetsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *likedTweetsResponse.Errors))
+		}
+
+		if likedTweetsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *likedTweetsResponse.Data...)
+		includes.Append(likedTweetsResponse.Includes)
+
+		if likedTweetsResponse.Meta == nil {
+			break
+		}
+
+		if likedTweetsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = likedTweetsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
+
+type LikeResponse struct {
+	Data *struct {
+		Liked bool `json:"liked"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// LikeTweet likes tweetID on behalf of userID, which must be the authenticated user
+func (service *Service) LikeTweet(userID string, tweetID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("LikeTweet")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		TweetID string `json:"tweet_id"`
+	}{
+		TweetID: tweetID,
+	}
+
+	likeResponse := LikeResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/likes", userID)),
+		BodyModel:     body,
+		ResponseModel: &likeResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if likeResponse.Errors != nil {
+		return false, errorsFound(request, response, *likeResponse.Errors)
+	}
+
+	if likeResponse.Data == nil {
+		return false, nil
+	}
+
+	return likeResponse.Data.Liked, nil
+}
+
+// UnlikeTweet removes the like of tweetID by userID, which must be the authenticated user
+func (service *Service) UnlikeTweet(userID string, tweetID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UnlikeTweet")
+	if e != nil {
+		return false, e
+	}
+
+	likeResponse := LikeResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/likes/%s", userID, tweetID)),
+		ResponseModel: &likeResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if likeResponse.Errors != nil {
+		return false, errorsFound(request, response, *likeResponse.Errors)
+	}
+
+	if likeResponse.Data == nil {
+		return false, nil
+	}
+
+	return likeResponse.Data.Liked, nil
+}
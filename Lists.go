@@ -0,0 +1,1394 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type ListResponse struct {
+	Data     *models.List     `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type ListsResponse struct {
+	Data     *[]models.List   `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Meta     *models.Meta     `json:"meta"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type ListExpansion string
+
+const (
+	ListExpansionOwnerID ListExpansion = "owner_id"
+)
+
+type ListField string
+
+const (
+	ListFieldCreatedAt     ListField = "created_at"
+	ListFieldDescription   ListField = "description"
+	ListFieldFollowerCount ListField = "follower_count"
+	ListFieldID            ListField = "id"
+	ListFieldMemberCount   ListField = "member_count"
+	ListFieldName          ListField = "name"
+	ListFieldOwnerID       ListField = "owner_id"
+	ListFieldPrivate       ListField = "private"
+)
+
+func setListFields(add bool, listFields *[]string, setListFields []ListField) {
+	elems := []string{}
+
+	if listFields != nil && add {
+		elems = *listFields
+	}
+
+	for _, listField := range setListFields {
+		for _, _elem := range elems {
+			if _elem == string(listField) {
+				goto next
+			}
+		}
+		elems = append(elems, string(listField))
+	next:
+	}
+	(*listFields) = elems
+}
+
+type GetListCall struct {
+	service    *Service
+	listID     string
+	Expansions *[]string `tw:"expansions"`
+	ListFields *[]string `tw:"list.fields"`
+	UserFields *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetListCall(listID string) *GetListCall {
+	return &GetListCall{
+		service: service,
+		listID:  listID,
+	}
+}
+
+func (call *GetListCall) SetExpansions(expansions ...ListExpansion) *GetListCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetListCall) SetListFields(listFields ...ListField) *GetListCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(false, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetListCall) AddListFields(listFields ...ListField) *GetListCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(true, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetListCall) SetUserFields(userFields ...UserField) *GetListCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListCall) AddUserFields(userFields ...UserField) *GetListCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListCall) Do() (*models.List, *models.Includes, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("lists/%s%s", call.listID, *params)
+
+	listResponse := ListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &listResponse,
+	}
+
+	endpoint := "lists"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if listResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *listResponse.Errors))
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	return listResponse.Data, listResponse.Includes, nil
+}
+
+type GetOwnedListsCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	ListFields      *[]string `tw:"list.fields"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetOwnedListsCall(userID string) *GetOwnedListsCall {
+	return &GetOwnedListsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetOwnedListsCall) SetExpansions(expansions ...ListExpansion) *GetOwnedListsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetOwnedListsCall) SetListFields(listFields ...ListField) *GetOwnedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(false, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetOwnedListsCall) AddListFields(listFields ...ListField) *GetOwnedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(true, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetOwnedListsCall) SetMaxResults(maxResults int) *GetOwnedListsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetOwnedListsCall) SetPaginationToken(paginationToken string) *GetOwnedListsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetOwnedListsCall) SetUserFields(userFields ...UserField) *GetOwnedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetOwnedListsCall) AddUserFields(userFields ...UserField) *GetOwnedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetOwnedListsCall) Do() (*[]models.List, *models.Includes, *errortools.Error) {
+	lists := []models.List{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/owned_lists%s", call.userID, *params)
+
+		ownedListsResponse := ListsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &ownedListsResponse,
+		}
+
+		endpoint := "users_owned_lists"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if ownedListsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *ownedListsResponse.Errors))
+		}
+
+		if ownedListsResponse.Data == nil {
+			break
+		}
+
+		lists = append(lists, *ownedListsResponse.Data...)
+		includes.Append(ownedListsResponse.Includes)
+
+		if ownedListsResponse.Meta == nil {
+			break
+		}
+
+		if ownedListsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = ownedListsResponse.Meta.NextToken
+	}
+
+	return &lists, &includes, nil
+}
+
+type GetListMembershipsCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	ListFields      *[]string `tw:"list.fields"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetListMembershipsCall(userID string) *GetListMembershipsCall {
+	return &GetListMembershipsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetListMembershipsCall) SetExpansions(expansions ...ListExpansion) *GetListMembershipsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetListMembershipsCall) SetListFields(listFields ...ListField) *GetListMembershipsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(false, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetListMembershipsCall) AddListFields(listFields ...ListField) *GetListMembershipsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(true, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetListMembershipsCall) SetMaxResults(maxResults int) *GetListMembershipsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetListMembershipsCall) SetPaginationToken(paginationToken string) *GetListMembershipsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetListMembershipsCall) SetUserFields(userFields ...UserField) *GetListMembershipsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListMembershipsCall) AddUserFields(userFields ...UserField) *GetListMembershipsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListMembershipsCall) Do() (*[]models.List, *models.Includes, *errortools.Error) {
+	lists := []models.List{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/list_memberships%s", call.userID, *params)
+
+		listMembershipsResponse := ListsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &listMembershipsResponse,
+		}
+
+		endpoint := "users_list_memberships"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if listMembershipsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *listMembershipsResponse.Errors))
+		}
+
+		if listMembershipsResponse.Data == nil {
+			break
+		}
+
+		lists = append(lists, *listMembershipsResponse.Data...)
+		includes.Append(listMembershipsResponse.Includes)
+
+		if listMembershipsResponse.Meta == nil {
+			break
+		}
+
+		if listMembershipsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = listMembershipsResponse.Meta.NextToken
+	}
+
+	return &lists, &includes, nil
+}
+
+type GetFollowedListsCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	ListFields      *[]string `tw:"list.fields"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetFollowedListsCall(userID string) *GetFollowedListsCall {
+	return &GetFollowedListsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetFollowedListsCall) SetExpansions(expansions ...ListExpansion) *GetFollowedListsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetFollowedListsCall) SetListFields(listFields ...ListField) *GetFollowedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(false, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetFollowedListsCall) AddListFields(listFields ...ListField) *GetFollowedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(true, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetFollowedListsCall) SetMaxResults(maxResults int) *GetFollowedListsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetFollowedListsCall) SetPaginationToken(paginationToken string) *GetFollowedListsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetFollowedListsCall) SetUserFields(userFields ...UserField) *GetFollowedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetFollowedListsCall) AddUserFields(userFields ...UserField) *GetFollowedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetFollowedListsCall) Do() (*[]models.List, *models.Includes, *errortools.Error) {
+	lists := []models.List{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/followed_lists%s", call.userID, *params)
+
+		followedListsResponse := ListsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &followedListsResponse,
+		}
+
+		endpoint := "users_followed_lists"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if followedListsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *followedListsResponse.Errors))
+		}
+
+		if followedListsResponse.Data == nil {
+			break
+		}
+
+		lists = append(lists, *followedListsResponse.Data...)
+		includes.Append(followedListsResponse.Includes)
+
+		if followedListsResponse.Meta == nil {
+			break
+		}
+
+		if followedListsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = followedListsResponse.Meta.NextToken
+	}
+
+	return &lists, &includes, nil
+}
+
+type GetPinnedListsCall struct {
+	service    *Service
+	userID     string
+	Expansions *[]string `tw:"expansions"`
+	ListFields *[]string `tw:"list.fields"`
+	UserFields *[]string `tw:"user.fields"`
+}
+
+// NewGetPinnedListsCall returns the lists pinned by userID, which must be the authenticated user
+func (service *Service) NewGetPinnedListsCall(userID string) *GetPinnedListsCall {
+	return &GetPinnedListsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetPinnedListsCall) SetExpansions(expansions ...ListExpansion) *GetPinnedListsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetPinnedListsCall) SetListFields(listFields ...ListField) *GetPinnedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(false, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetPinnedListsCall) AddListFields(listFields ...ListField) *GetPinnedListsCall {
+	if call.ListFields == nil {
+		call.ListFields = &[]string{}
+	}
+	setListFields(true, call.ListFields, listFields)
+	return call
+}
+
+func (call *GetPinnedListsCall) SetUserFields(userFields ...UserField) *GetPinnedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetPinnedListsCall) AddUserFields(userFields ...UserField) *GetPinnedListsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetPinnedListsCall) Do() (*[]models.List, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetPinnedListsCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("users/%s/pinned_lists%s", call.userID, *params)
+
+	pinnedListsResponse := ListsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &pinnedListsResponse,
+	}
+
+	endpoint := "users_pinned_lists"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	if pinnedListsResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *pinnedListsResponse.Errors))
+	}
+
+	if pinnedListsResponse.Data == nil {
+		return &[]models.List{}, pinnedListsResponse.Includes, nil
+	}
+
+	return pinnedListsResponse.Data, pinnedListsResponse.Includes, nil
+}
+
+type GetListMembersCall struct {
+	service         *Service
+	listID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetListMembersCall(listID string) *GetListMembersCall {
+	return &GetListMembersCall{
+		service: service,
+		listID:  listID,
+	}
+}
+
+func (call *GetListMembersCall) SetExpansions(expansions ...UserExpansion) *GetListMembersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetListMembersCall) SetMaxResults(maxResults int) *GetListMembersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetListMembersCall) SetPaginationToken(paginationToken string) *GetListMembersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetListMembersCall) SetTweetFields(tweetFields ...TweetField) *GetListMembersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListMembersCall) AddTweetFields(tweetFields ...TweetField) *GetListMembersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListMembersCall) SetUserFields(userFields ...UserField) *GetListMembersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListMembersCall) AddUserFields(userFields ...UserField) *GetListMembersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListMembersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("lists/%s/members%s", call.listID, *params)
+
+		listMembersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &listMembersResponse,
+		}
+
+		endpoint := "lists_members"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if listMembersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *listMembersResponse.Errors))
+		}
+
+		if listMembersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *listMembersResponse.Data...)
+		includes.Append(listMembersResponse.Includes)
+
+		if listMembersResponse.Meta == nil {
+			break
+		}
+
+		if listMembersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = listMembersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type GetListFollowersCall struct {
+	service         *Service
+	listID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetListFollowersCall(listID string) *GetListFollowersCall {
+	return &GetListFollowersCall{
+		service: service,
+		listID:  listID,
+	}
+}
+
+func (call *GetListFollowersCall) SetExpansions(expansions ...UserExpansion) *GetListFollowersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetListFollowersCall) SetMaxResults(maxResults int) *GetListFollowersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetListFollowersCall) SetPaginationToken(paginationToken string) *GetListFollowersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetListFollowersCall) SetTweetFields(tweetFields ...TweetField) *GetListFollowersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListFollowersCall) AddTweetFields(tweetFields ...TweetField) *GetListFollowersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListFollowersCall) SetUserFields(userFields ...UserField) *GetListFollowersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListFollowersCall) AddUserFields(userFields ...UserField) *GetListFollowersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListFollowersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("lists/%s/followers%s", call.listID, *params)
+
+		listFollowersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &listFollowersResponse,
+		}
+
+		endpoint := "lists_followers"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if listFollowersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *listFollowersResponse.Errors))
+		}
+
+		if listFollowersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *listFollowersResponse.Data...)
+		includes.Append(listFollowersResponse.Includes)
+
+		if listFollowersResponse.Meta == nil {
+			break
+		}
+
+		if listFollowersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = listFollowersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type GetListTweetsCall struct {
+	service         *Service
+	listID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetListTweetsCall(listID string) *GetListTweetsCall {
+	return &GetListTweetsCall{
+		service: service,
+		listID:  listID,
+	}
+}
+
+func (call *GetListTweetsCall) SetExpansions(expansions ...TweetExpansion) *GetListTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetListTweetsCall) SetMaxResults(maxResults int) *GetListTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetListTweetsCall) SetMediaFields(mediaFields ...MediaField) *GetListTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetListTweetsCall) AddMediaFields(mediaFields ...MediaField) *GetListTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetListTweetsCall) SetPaginationToken(paginationToken string) *GetListTweetsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetListTweetsCall) SetPlaceFields(placeFields ...PlaceField) *GetListTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetListTweetsCall) AddPlaceFields(placeFields ...PlaceField) *GetListTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetListTweetsCall) SetPollFields(pollFields ...PollField) *GetListTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetListTweetsCall) AddPollFields(pollFields ...PollField) *GetListTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetListTweetsCall) SetTweetFields(tweetFields ...TweetField) *GetListTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListTweetsCall) AddTweetFields(tweetFields ...TweetField) *GetListTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetListTweetsCall) SetUserFields(userFields ...UserField) *GetListTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListTweetsCall) AddUserFields(userFields ...UserField) *GetListTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetListTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("lists/%s/tweets%s", call.listID, *params)
+
+		listTweetsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &listTweetsResponse,
+		}
+
+		endpoint := "lists_tweets"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if listTweetsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *listTweetsResponse.Errors))
+		}
+
+		if listTweetsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *listTweetsResponse.Data...)
+		includes.Append(listTweetsResponse.Includes)
+
+		if listTweetsResponse.Meta == nil {
+			break
+		}
+
+		if listTweetsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = listTweetsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
+
+type CreateListConfig struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Private     *bool   `json:"private,omitempty"`
+}
+
+// CreateList creates a list owned by the authenticated user and returns its id and name
+func (service *Service) CreateList(config *CreateListConfig) (*models.List, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("CreateListConfig must not be a nil pointer")
+	}
+
+	e := service.requireUserContext("CreateList")
+	if e != nil {
+		return nil, e
+	}
+
+	listResponse := ListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url("lists"),
+		BodyModel:     config,
+		ResponseModel: &listResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if listResponse.Errors != nil {
+		return nil, errorsFound(request, response, *listResponse.Errors)
+	}
+
+	if listResponse.Data == nil {
+		return nil, errortools.ErrorMessage("Response does not contain the created list")
+	}
+
+	return listResponse.Data, nil
+}
+
+type UpdateListConfig struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Private     *bool   `json:"private,omitempty"`
+}
+
+type UpdateListResponse struct {
+	Data *struct {
+		Updated bool `json:"updated"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// UpdateList updates the metadata of a list owned by the authenticated user
+func (service *Service) UpdateList(listID string, config *UpdateListConfig) (bool, *errortools.Error) {
+	if config == nil {
+		return false, errortools.ErrorMessage("UpdateListConfig must not be a nil pointer")
+	}
+
+	e := service.requireUserContext("UpdateList")
+	if e != nil {
+		return false, e
+	}
+
+	updateListResponse := UpdateListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("lists/%s", listID)),
+		BodyModel:     config,
+		ResponseModel: &updateListResponse,
+	}
+
+	request, response, e := service.put(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if updateListResponse.Errors != nil {
+		return false, errorsFound(request, response, *updateListResponse.Errors)
+	}
+
+	if updateListResponse.Data == nil {
+		return false, nil
+	}
+
+	return updateListResponse.Data.Updated, nil
+}
+
+type DeleteListResponse struct {
+	Data *struct {
+		Deleted bool `json:"deleted"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// DeleteList deletes a list owned by the authenticated user
+func (service *Service) DeleteList(listID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("DeleteList")
+	if e != nil {
+		return false, e
+	}
+
+	deleteListResponse := DeleteListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("lists/%s", listID)),
+		ResponseModel: &deleteListResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if deleteListResponse.Errors != nil {
+		return false, errorsFound(request, response, *deleteListResponse.Errors)
+	}
+
+	if deleteListResponse.Data == nil {
+		return false, nil
+	}
+
+	return deleteListResponse.Data.Deleted, nil
+}
+
+type ListMemberResponse struct {
+	Data *struct {
+		IsMember bool `json:"is_member"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// AddListMember adds userID to a list owned by the authenticated user
+func (service *Service) AddListMember(listID string, userID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("AddListMember")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		UserID string `json:"user_id"`
+	}{
+		UserID: userID,
+	}
+
+	listMemberResponse := ListMemberResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("lists/%s/members", listID)),
+		BodyModel:     body,
+		ResponseModel: &listMemberResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if listMemberResponse.Errors != nil {
+		return false, errorsFound(request, response, *listMemberResponse.Errors)
+	}
+
+	if listMemberResponse.Data == nil {
+		return false, nil
+	}
+
+	return listMemberResponse.Data.IsMember, nil
+}
+
+// RemoveListMember removes userID from a list owned by the authenticated user
+func (service *Service) RemoveListMember(listID string, userID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("RemoveListMember")
+	if e != nil {
+		return false, e
+	}
+
+	listMemberResponse := ListMemberResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("lists/%s/members/%s", listID, userID)),
+		ResponseModel: &listMemberResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if listMemberResponse.Errors != nil {
+		return false, errorsFound(request, response, *listMemberResponse.Errors)
+	}
+
+	if listMemberResponse.Data == nil {
+		return false, nil
+	}
+
+	return listMemberResponse.Data.IsMember, nil
+}
+
+type FollowListResponse struct {
+	Data *struct {
+		Following bool `json:"following"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// FollowList lets userID, which must be the authenticated user, follow listID
+func (service *Service) FollowList(userID string, listID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("FollowList")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		ListID string `json:"list_id"`
+	}{
+		ListID: listID,
+	}
+
+	followListResponse := FollowListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/followed_lists", userID)),
+		BodyModel:     body,
+		ResponseModel: &followListResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if followListResponse.Errors != nil {
+		return false, errorsFound(request, response, *followListResponse.Errors)
+	}
+
+	if followListResponse.Data == nil {
+		return false, nil
+	}
+
+	return followListResponse.Data.Following, nil
+}
+
+// UnfollowList lets userID, which must be the authenticated user, unfollow listID
+func (service *Service) UnfollowList(userID string, listID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UnfollowList")
+	if e != nil {
+		return false, e
+	}
+
+	followListResponse := FollowListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/followed_lists/%s", userID, listID)),
+		ResponseModel: &followListResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if followListResponse.Errors != nil {
+		return false, errorsFound(request, response, *followListResponse.Errors)
+	}
+
+	if followListResponse.Data == nil {
+		return false, nil
+	}
+
+	return followListResponse.Data.Following, nil
+}
+
+type PinListResponse struct {
+	Data *struct {
+		Pinned bool `json:"pinned"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// PinList lets userID, which must be the authenticated user, pin listID
+func (service *Service) PinList(userID string, listID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("PinList")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		ListID string `json:"list_id"`
+	}{
+		ListID: listID,
+	}
+
+	pinListResponse := PinListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/pinned_lists", userID)),
+		BodyModel:     body,
+		ResponseModel: &pinListResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if pinListResponse.Errors != nil {
+		return false, errorsFound(request, response, *pinListResponse.Errors)
+	}
+
+	if pinListResponse.Data == nil {
+		return false, nil
+	}
+
+	return pinListResponse.Data.Pinned, nil
+}
+
+// UnpinList lets userID, which must be the authenticated user, unpin listID
+func (service *Service) UnpinList(userID string, listID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UnpinList")
+	if e != nil {
+		return false, e
+	}
+
+	pinListResponse := PinListResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/pinned_lists/%s", userID, listID)),
+		ResponseModel: &pinListResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if pinListResponse.Errors != nil {
+		return false, errorsFound(request, response, *pinListResponse.Errors)
+	}
+
+	if pinListResponse.Data == nil {
+		return false, nil
+	}
+
+	return pinListResponse.Data.Pinned, nil
+}
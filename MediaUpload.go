@@ -0,0 +1,294 @@
+package twitter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+const (
+	defaultMediaUploadChunkSize   int   = 4 * 1024 * 1024
+	maximumMediaUploadChunkSize   int   = 5 * 1024 * 1024
+	defaultMediaCheckAfterSeconds int64 = 5
+)
+
+type MediaCategory string
+
+const (
+	MediaCategoryAmplifyVideo MediaCategory = "amplify_video"
+	MediaCategoryTweetGif     MediaCategory = "tweet_gif"
+	MediaCategoryTweetImage   MediaCategory = "tweet_image"
+	MediaCategoryTweetVideo   MediaCategory = "tweet_video"
+)
+
+type MediaProcessingState string
+
+const (
+	MediaProcessingStatePending    MediaProcessingState = "pending"
+	MediaProcessingStateInProgress MediaProcessingState = "in_progress"
+	MediaProcessingStateFailed     MediaProcessingState = "failed"
+	MediaProcessingStateSucceeded  MediaProcessingState = "succeeded"
+)
+
+type MediaUploadResponse struct {
+	MediaID          int64                `json:"media_id"`
+	MediaIDString    string               `json:"media_id_string"`
+	Size             *int64               `json:"size"`
+	ExpiresAfterSecs *int64               `json:"expires_after_secs"`
+	ProcessingInfo   *MediaProcessingInfo `json:"processing_info"`
+}
+
+type MediaProcessingInfo struct {
+	State           MediaProcessingState  `json:"state"`
+	CheckAfterSecs  *int64                `json:"check_after_secs"`
+	ProgressPercent *int64                `json:"progress_percent"`
+	Error           *MediaProcessingError `json:"error"`
+}
+
+type MediaProcessingError struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+type UploadMediaConfig struct {
+	Reader        io.Reader
+	TotalBytes    int64
+	MediaType     string
+	MediaCategory *MediaCategory
+	ChunkSize     *int
+	AltText       *string
+	// Progress is called after every uploaded chunk
+	Progress func(uploadedBytes int64, totalBytes int64)
+}
+
+// UploadMedia uploads media in chunks and waits for it to be processed,
+// it returns the media_id_string to be used in CreateTweetConfigMedia
+func (service *Service) UploadMedia(config *UploadMediaConfig) (string, *errortools.Error) {
+	if config == nil {
+		return "", errortools.ErrorMessage("UploadMediaConfig must not be a nil pointer")
+	}
+
+	if config.Reader == nil {
+		return "", errortools.ErrorMessage("Reader not provided")
+	}
+
+	if config.TotalBytes <= 0 {
+		return "", errortools.ErrorMessage("TotalBytes not provided")
+	}
+
+	if config.MediaType == "" {
+		return "", errortools.ErrorMessage("MediaType not provided")
+	}
+
+	chunkSize := defaultMediaUploadChunkSize
+	if config.ChunkSize != nil {
+		chunkSize = *config.ChunkSize
+	}
+
+	if chunkSize <= 0 || chunkSize > maximumMediaUploadChunkSize {
+		return "", errortools.ErrorMessagef("ChunkSize must be between 1 and %v bytes", maximumMediaUploadChunkSize)
+	}
+
+	e := service.requireUserContext("UploadMedia")
+	if e != nil {
+		return "", e
+	}
+
+	// INIT
+	params := url.Values{}
+	params.Set("command", "INIT")
+	params.Set("total_bytes", strconv.FormatInt(config.TotalBytes, 10))
+	params.Set("media_type", config.MediaType)
+	if config.MediaCategory != nil {
+		params.Set("media_category", string(*config.MediaCategory))
+	}
+
+	initResponse := MediaUploadResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.urlUploadV1(fmt.Sprintf("media/upload.json?%s", params.Encode())),
+		ResponseModel: &initResponse,
+	}
+
+	_, _, e = service.post(&requestConfig)
+	if e != nil {
+		return "", e
+	}
+
+	mediaID := initResponse.MediaIDString
+
+	// APPEND
+	var uploadedBytes int64 = 0
+	chunk := make([]byte, chunkSize)
+
+	for segmentIndex := 0; ; segmentIndex++ {
+		n, err := io.ReadFull(config.Reader, chunk)
+		if n == 0 {
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", errortools.ErrorMessage(err)
+			}
+		}
+
+		e = service.appendMedia(mediaID, segmentIndex, chunk[:n])
+		if e != nil {
+			return "", e
+		}
+
+		uploadedBytes += int64(n)
+		if config.Progress != nil {
+			config.Progress(uploadedBytes, config.TotalBytes)
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return "", errortools.ErrorMessage(err)
+		}
+	}
+
+	// FINALIZE
+	params = url.Values{}
+	params.Set("command", "FINALIZE")
+	params.Set("media_id", mediaID)
+
+	finalizeResponse := MediaUploadResponse{}
+	requestConfig = go_http.RequestConfig{
+		Url:           service.urlUploadV1(fmt.Sprintf("media/upload.json?%s", params.Encode())),
+		ResponseModel: &finalizeResponse,
+	}
+
+	_, _, e = service.post(&requestConfig)
+	if e != nil {
+		return "", e
+	}
+
+	// STATUS
+	processingInfo := finalizeResponse.ProcessingInfo
+	for processingInfo != nil {
+		if processingInfo.State == MediaProcessingStateSucceeded {
+			break
+		}
+
+		if processingInfo.State == MediaProcessingStateFailed {
+			if processingInfo.Error != nil {
+				return "", errortools.ErrorMessagef("Processing of media %s failed: %s", mediaID, processingInfo.Error.Message)
+			}
+			return "", errortools.ErrorMessagef("Processing of media %s failed", mediaID)
+		}
+
+		checkAfterSeconds := defaultMediaCheckAfterSeconds
+		if processingInfo.CheckAfterSecs != nil {
+			checkAfterSeconds = *processingInfo.CheckAfterSecs
+		}
+		time.Sleep(time.Duration(checkAfterSeconds) * time.Second)
+
+		processingInfo, e = service.getMediaProcessingInfo(mediaID)
+		if e != nil {
+			return "", e
+		}
+	}
+
+	if config.AltText != nil {
+		e = service.createMediaMetadata(mediaID, *config.AltText)
+		if e != nil {
+			return "", e
+		}
+	}
+
+	return mediaID, nil
+}
+
+func (service *Service) appendMedia(mediaID string, segmentIndex int, chunk []byte) *errortools.Error {
+	params := url.Values{}
+	params.Set("command", "APPEND")
+	params.Set("media_id", mediaID)
+	params.Set("segment_index", strconv.Itoa(segmentIndex))
+
+	body := bytes.Buffer{}
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("media", "media")
+	if err != nil {
+		return errortools.ErrorMessage(err)
+	}
+
+	_, err = part.Write(chunk)
+	if err != nil {
+		return errortools.ErrorMessage(err)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return errortools.ErrorMessage(err)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", writer.FormDataContentType())
+
+	b := body.Bytes()
+	requestConfig := go_http.RequestConfig{
+		Url:               service.urlUploadV1(fmt.Sprintf("media/upload.json?%s", params.Encode())),
+		BodyRaw:           &b,
+		NonDefaultHeaders: &header,
+	}
+
+	_, _, e := service.post(&requestConfig)
+
+	return e
+}
+
+func (service *Service) getMediaProcessingInfo(mediaID string) (*MediaProcessingInfo, *errortools.Error) {
+	params := url.Values{}
+	params.Set("command", "STATUS")
+	params.Set("media_id", mediaID)
+
+	statusResponse := MediaUploadResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.urlUploadV1(fmt.Sprintf("media/upload.json?%s", params.Encode())),
+		ResponseModel: &statusResponse,
+	}
+
+	_, _, e := service.get(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return statusResponse.ProcessingInfo, nil
+}
+
+func (service *Service) createMediaMetadata(mediaID string, altText string) *errortools.Error {
+	type AltText struct {
+		Text string `json:"text"`
+	}
+
+	var body = struct {
+		MediaID string  `json:"media_id"`
+		AltText AltText `json:"alt_text"`
+	}{
+		MediaID: mediaID,
+		AltText: AltText{
+			Text: altText,
+		},
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Url:       service.urlUploadV1("media/metadata/create.json"),
+		BodyModel: body,
+	}
+
+	_, _, e := service.post(&requestConfig)
+
+	return e
+}
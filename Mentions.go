@@ -0,0 +1,220 @@
+package twitter
+
+import (
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetUserMentionsCall struct {
+	service         *Service
+	userID          string
+	EndTime         *time.Time `tw:"end_time"`
+	Expansions      *[]string  `tw:"expansions"`
+	MaxResults      *int       `tw:"max_results"`
+	MediaFields     *[]string  `tw:"media.fields"`
+	PaginationToken *string    `tw:"pagination_token"`
+	PlaceFields     *[]string  `tw:"place.fields"`
+	PollFields      *[]string  `tw:"poll.fields"`
+	SinceID         *string    `tw:"since_id"`
+	StartTime       *time.Time `tw:"start_time"`
+	TweetFields     *[]string  `tw:"tweet.fields"`
+	UntilID         *string    `tw:"until_id"`
+	UserFields      *[]string  `tw:"user.fields"`
+}
+
+func (service *Service) NewGetUserMentionsCall(userID string) *GetUserMentionsCall {
+	return &GetUserMentionsCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetUserMentionsCall) SetEndTime(endTime time.Time) *GetUserMentionsCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetExpansions(expansions ...TweetExpansion) *GetUserMentionsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetMaxResults(maxResults int) *GetUserMentionsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetMediaFields(mediaFields ...MediaField) *GetUserMentionsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) AddMediaFields(mediaFields ...MediaField) *GetUserMentionsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) SetPaginationToken(paginationToken string) *GetUserMentionsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetPlaceFields(placeFields ...PlaceField) *GetUserMentionsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) AddPlaceFields(placeFields ...PlaceField) *GetUserMentionsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) SetPollFields(pollFields ...PollField) *GetUserMentionsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) AddPollFields(pollFields ...PollField) *GetUserMentionsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) SetSinceID(sinceID string) *GetUserMentionsCall {
+	(*call).SinceID = &sinceID
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetStartTime(startTime time.Time) *GetUserMentionsCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetTweetFields(tweetFields ...TweetField) *GetUserMentionsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) AddTweetFields(tweetFields ...TweetField) *GetUserMentionsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) SetUntilID(untilID string) *GetUserMentionsCall {
+	(*call).UntilID = &untilID
+
+	return call
+}
+
+func (call *GetUserMentionsCall) SetUserFields(userFields ...UserField) *GetUserMentionsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) AddUserFields(userFields ...UserField) *GetUserMentionsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUserMentionsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/mentions%s", call.userID, *params)
+
+		mentionsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &mentionsResponse,
+		}
+
+		endpoint := "users_mentions"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if mentionsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *mentionsResponse.Errors))
+		}
+
+		if mentionsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *mentionsResponse.Data...)
+		includes.Append(mentionsResponse.Includes)
+
+		if mentionsResponse.Meta == nil {
+			break
+		}
+
+		if mentionsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = mentionsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
@@ -0,0 +1,212 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetMutedUsersCall struct {
+	service         *Service
+	userID          string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetMutedUsersCall returns the users muted by userID, which must be the authenticated user
+func (service *Service) NewGetMutedUsersCall(userID string) *GetMutedUsersCall {
+	return &GetMutedUsersCall{
+		service: service,
+		userID:  userID,
+	}
+}
+
+func (call *GetMutedUsersCall) SetExpansions(expansions ...UserExpansion) *GetMutedUsersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetMutedUsersCall) SetMaxResults(maxResults int) *GetMutedUsersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetMutedUsersCall) SetPaginationToken(paginationToken string) *GetMutedUsersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetMutedUsersCall) SetTweetFields(tweetFields ...TweetField) *GetMutedUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetMutedUsersCall) AddTweetFields(tweetFields ...TweetField) *GetMutedUsersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetMutedUsersCall) SetUserFields(userFields ...UserField) *GetMutedUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetMutedUsersCall) AddUserFields(userFields ...UserField) *GetMutedUsersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetMutedUsersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetMutedUsersCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/%s/muting%s", call.userID, *params)
+
+		mutedUsersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &mutedUsersResponse,
+		}
+
+		endpoint := "users_muting"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if mutedUsersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *mutedUsersResponse.Errors))
+		}
+
+		if mutedUsersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *mutedUsersResponse.Data...)
+		includes.Append(mutedUsersResponse.Includes)
+
+		if mutedUsersResponse.Meta == nil {
+			break
+		}
+
+		if mutedUsersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = mutedUsersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type MutingResponse struct {
+	Data *struct {
+		Muting bool `json:"muting"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// MuteUser mutes targetUserID on behalf of sourceUserID, which must be the authenticated user
+func (service *Service) MuteUser(sourceUserID string, targetUserID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("MuteUser")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		TargetUserID string `json:"target_user_id"`
+	}{
+		TargetUserID: targetUserID,
+	}
+
+	mutingResponse := MutingResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/muting", sourceUserID)),
+		BodyModel:     body,
+		ResponseModel: &mutingResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if mutingResponse.Errors != nil {
+		return false, errorsFound(request, response, *mutingResponse.Errors)
+	}
+
+	if mutingResponse.Data == nil {
+		return false, nil
+	}
+
+	return mutingResponse.Data.Muting, nil
+}
+
+// UnmuteUser unmutes targetUserID on behalf of sourceUserID, which must be the authenticated user
+func (service *Service) UnmuteUser(sourceUserID string, targetUserID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UnmuteUser")
+	if e != nil {
+		return false, e
+	}
+
+	mutingResponse := MutingResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/muting/%s", sourceUserID, targetUserID)),
+		ResponseModel: &mutingResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if mutingResponse.Errors != nil {
+		return false, errorsFound(request, response, *mutingResponse.Errors)
+	}
+
+	if mutingResponse.Data == nil {
+		return false, nil
+	}
+
+	return mutingResponse.Data.Muting, nil
+}
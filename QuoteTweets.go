@@ -0,0 +1,203 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetQuoteTweetsCall struct {
+	service         *Service
+	tweetID         string
+	Exclude         *[]string `tw:"exclude"`
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetQuoteTweetsCall(tweetID string) *GetQuoteTweetsCall {
+	return &GetQuoteTweetsCall{
+		service: service,
+		tweetID: tweetID,
+	}
+}
+
+func (call *GetQuoteTweetsCall) SetExclude(excludes ...Exclude) *GetQuoteTweetsCall {
+	elems := []string{}
+
+	for _, elem := range excludes {
+		elems = append(elems, string(elem))
+	}
+	(*call).Exclude = &elems
+
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetExpansions(expansions ...TweetExpansion) *GetQuoteTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetMaxResults(maxResults int) *GetQuoteTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetMediaFields(mediaFields ...MediaField) *GetQuoteTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) AddMediaFields(mediaFields ...MediaField) *GetQuoteTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetPaginationToken(paginationToken string) *GetQuoteTweetsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetPlaceFields(placeFields ...PlaceField) *GetQuoteTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) AddPlaceFields(placeFields ...PlaceField) *GetQuoteTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetPollFields(pollFields ...PollField) *GetQuoteTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) AddPollFields(pollFields ...PollField) *GetQuoteTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetTweetFields(tweetFields ...TweetField) *GetQuoteTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) AddTweetFields(tweetFields ...TweetField) *GetQuoteTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) SetUserFields(userFields ...UserField) *GetQuoteTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) AddUserFields(userFields ...UserField) *GetQuoteTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetQuoteTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/%s/quote_tweets%s", call.tweetID, *params)
+
+		quoteTweetsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &quoteTweetsResponse,
+		}
+
+		endpoint := "tweets_quote_tweets"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if quoteTweetsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *quoteTweetsResponse.Errors))
+		}
+
+		if quoteTweetsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *quoteTweetsResponse.Data...)
+		includes.Append(quoteTweetsResponse.Includes)
+
+		if quoteTweetsResponse.Meta == nil {
+			break
+		}
+
+		if quoteTweetsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = quoteTweetsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
@@ -0,0 +1,389 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type GetRetweetedByCall struct {
+	service         *Service
+	tweetID         string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetRetweetedByCall(tweetID string) *GetRetweetedByCall {
+	return &GetRetweetedByCall{
+		service: service,
+		tweetID: tweetID,
+	}
+}
+
+func (call *GetRetweetedByCall) SetExpansions(expansions ...UserExpansion) *GetRetweetedByCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetRetweetedByCall) SetMaxResults(maxResults int) *GetRetweetedByCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetRetweetedByCall) SetPaginationToken(paginationToken string) *GetRetweetedByCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetRetweetedByCall) SetTweetFields(tweetFields ...TweetField) *GetRetweetedByCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetRetweetedByCall) AddTweetFields(tweetFields ...TweetField) *GetRetweetedByCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetRetweetedByCall) SetUserFields(userFields ...UserField) *GetRetweetedByCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetRetweetedByCall) AddUserFields(userFields ...UserField) *GetRetweetedByCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetRetweetedByCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/%s/retweeted_by%s", call.tweetID, *params)
+
+		retweetedByResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &retweetedByResponse,
+		}
+
+		endpoint := "tweets_retweeted_by"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if retweetedByResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *retweetedByResponse.Errors))
+		}
+
+		if retweetedByResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *retweetedByResponse.Data...)
+		includes.Append(retweetedByResponse.Includes)
+
+		if retweetedByResponse.Meta == nil {
+			break
+		}
+
+		if retweetedByResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = retweetedByResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
+
+type GetRetweetsCall struct {
+	service         *Service
+	tweetID         string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PaginationToken *string   `tw:"pagination_token"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetRetweetsCall returns the retweets of tweetID as tweets
+func (service *Service) NewGetRetweetsCall(tweetID string) *GetRetweetsCall {
+	return &GetRetweetsCall{
+		service: service,
+		tweetID: tweetID,
+	}
+}
+
+func (call *GetRetweetsCall) SetExpansions(expansions ...TweetExpansion) *GetRetweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetRetweetsCall) SetMaxResults(maxResults int) *GetRetweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetRetweetsCall) SetMediaFields(mediaFields ...MediaField) *GetRetweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetRetweetsCall) AddMediaFields(mediaFields ...MediaField) *GetRetweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetRetweetsCall) SetPaginationToken(paginationToken string) *GetRetweetsCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetRetweetsCall) SetPlaceFields(placeFields ...PlaceField) *GetRetweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetRetweetsCall) AddPlaceFields(placeFields ...PlaceField) *GetRetweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetRetweetsCall) SetPollFields(pollFields ...PollField) *GetRetweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetRetweetsCall) AddPollFields(pollFields ...PollField) *GetRetweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetRetweetsCall) SetTweetFields(tweetFields ...TweetField) *GetRetweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetRetweetsCall) AddTweetFields(tweetFields ...TweetField) *GetRetweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetRetweetsCall) SetUserFields(userFields ...UserField) *GetRetweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetRetweetsCall) AddUserFields(userFields ...UserField) *GetRetweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetRetweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/%s/retweets%s", call.tweetID, *params)
+
+		retweetsResponse := UserTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &retweetsResponse,
+		}
+
+		endpoint := "tweets_retweets"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if retweetsResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *retweetsResponse.Errors))
+		}
+
+		if retweetsResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *retweetsResponse.Data...)
+		includes.Append(retweetsResponse.Includes)
+
+		if retweetsResponse.Meta == nil {
+			break
+		}
+
+		if retweetsResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = retweetsResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
+
+type RetweetResponse struct {
+	Data *struct {
+		Retweeted bool `json:"retweeted"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// Retweet retweets tweetID on behalf of userID, which must be the authenticated user
+func (service *Service) Retweet(userID string, tweetID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("Retweet")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		TweetID string `json:"tweet_id"`
+	}{
+		TweetID: tweetID,
+	}
+
+	retweetResponse := RetweetResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/retweets", userID)),
+		BodyModel:     body,
+		ResponseModel: &retweetResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if retweetResponse.Errors != nil {
+		return false, errorsFound(request, response, *retweetResponse.Errors)
+	}
+
+	if retweetResponse.Data == nil {
+		return false, nil
+	}
+
+	return retweetResponse.Data.Retweeted, nil
+}
+
+// UndoRetweet removes the retweet of tweetID by userID, which must be the authenticated user
+func (service *Service) UndoRetweet(userID string, tweetID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("UndoRetweet")
+	if e != nil {
+		return false, e
+	}
+
+	retweetResponse := RetweetResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("users/%s/retweets/%s", userID, tweetID)),
+		ResponseModel: &retweetResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if retweetResponse.Errors != nil {
+		return false, errorsFound(request, response, *retweetResponse.Errors)
+	}
+
+	if retweetResponse.Data == nil {
+		return false, nil
+	}
+
+	return retweetResponse.Data.Retweeted, nil
+}
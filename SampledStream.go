@@ -0,0 +1,164 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type SampledStreamResponse struct {
+	Data     *models.Tweet    `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type GetSampledStreamCall struct {
+	streamer
+	service         *Service
+	BackfillMinutes *int      `tw:"backfill_minutes"`
+	Expansions      *[]string `tw:"expansions"`
+	MediaFields     *[]string `tw:"media.fields"`
+	PlaceFields     *[]string `tw:"place.fields"`
+	PollFields      *[]string `tw:"poll.fields"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetSampledStreamCall() *GetSampledStreamCall {
+	return &GetSampledStreamCall{
+		service: service,
+	}
+}
+
+// SetBackfillMinutes requests up to five minutes of tweets missed during a disconnect to be redelivered on reconnect
+func (call *GetSampledStreamCall) SetBackfillMinutes(backfillMinutes int) *GetSampledStreamCall {
+	(*call).BackfillMinutes = &backfillMinutes
+
+	return call
+}
+
+func (call *GetSampledStreamCall) SetExpansions(expansions ...TweetExpansion) *GetSampledStreamCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSampledStreamCall) SetMediaFields(mediaFields ...MediaField) *GetSampledStreamCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) AddMediaFields(mediaFields ...MediaField) *GetSampledStreamCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) SetPlaceFields(placeFields ...PlaceField) *GetSampledStreamCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) AddPlaceFields(placeFields ...PlaceField) *GetSampledStreamCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) SetPollFields(pollFields ...PollField) *GetSampledStreamCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) AddPollFields(pollFields ...PollField) *GetSampledStreamCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) SetTweetFields(tweetFields ...TweetField) *GetSampledStreamCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) AddTweetFields(tweetFields ...TweetField) *GetSampledStreamCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) SetUserFields(userFields ...UserField) *GetSampledStreamCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSampledStreamCall) AddUserFields(userFields ...UserField) *GetSampledStreamCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+// Do consumes the stream and passes every tweet to callback, it blocks until Stop is called
+func (call *GetSampledStreamCall) Do(callback func(response *SampledStreamResponse)) *errortools.Error {
+	backfillMinutes := call.BackfillMinutes
+	defer func() { call.BackfillMinutes = backfillMinutes }()
+
+	url := func(reconnect bool) (string, *errortools.Error) {
+		// backfill only applies to the tweets missed during a disconnect
+		call.BackfillMinutes = nil
+		if reconnect {
+			call.BackfillMinutes = backfillMinutes
+		}
+
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return "", e
+		}
+
+		return call.service.url(fmt.Sprintf("tweets/sample/stream%s", *params)), nil
+	}
+
+	return call.service.stream(url, &call.streamer, func(line []byte) {
+		sampledStreamResponse := SampledStreamResponse{}
+
+		err := json.Unmarshal(line, &sampledStreamResponse)
+		if err != nil {
+			errortools.CaptureError(err)
+			return
+		}
+
+		callback(&sampledStreamResponse)
+	})
+}
@@ -0,0 +1,474 @@
+package twitter
+
+import (
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+const (
+	// full-archive search allows one request per second
+	searchAllTweetsInterval time.Duration = time.Second
+)
+
+type SearchTweetsResponse struct {
+	Data     *[]models.Tweet  `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Meta     *models.Meta     `json:"meta"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type SortOrder string
+
+const (
+	SortOrderRecency   SortOrder = "recency"
+	SortOrderRelevancy SortOrder = "relevancy"
+)
+
+type SearchRecentTweetsCall struct {
+	service     *Service
+	Query       string     `tw:"query"`
+	EndTime     *time.Time `tw:"end_time"`
+	Expansions  *[]string  `tw:"expansions"`
+	MaxResults  *int       `tw:"max_results"`
+	MediaFields *[]string  `tw:"media.fields"`
+	NextToken   *string    `tw:"next_token"`
+	PlaceFields *[]string  `tw:"place.fields"`
+	PollFields  *[]string  `tw:"poll.fields"`
+	SinceID     *string    `tw:"since_id"`
+	SortOrder   *string    `tw:"sort_order"`
+	StartTime   *time.Time `tw:"start_time"`
+	TweetFields *[]string  `tw:"tweet.fields"`
+	UntilID     *string    `tw:"until_id"`
+	UserFields  *[]string  `tw:"user.fields"`
+}
+
+func (service *Service) NewSearchRecentTweetsCall(query string) *SearchRecentTweetsCall {
+	return &SearchRecentTweetsCall{
+		service: service,
+		Query:   query,
+	}
+}
+
+func (call *SearchRecentTweetsCall) SetEndTime(endTime time.Time) *SearchRecentTweetsCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetExpansions(expansions ...TweetExpansion) *SearchRecentTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetMaxResults(maxResults int) *SearchRecentTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetMediaFields(mediaFields ...MediaField) *SearchRecentTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) AddMediaFields(mediaFields ...MediaField) *SearchRecentTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetNextToken(nextToken string) *SearchRecentTweetsCall {
+	(*call).NextToken = &nextToken
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetPlaceFields(placeFields ...PlaceField) *SearchRecentTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) AddPlaceFields(placeFields ...PlaceField) *SearchRecentTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetPollFields(pollFields ...PollField) *SearchRecentTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) AddPollFields(pollFields ...PollField) *SearchRecentTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetSinceID(sinceID string) *SearchRecentTweetsCall {
+	(*call).SinceID = &sinceID
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetSortOrder(sortOrder SortOrder) *SearchRecentTweetsCall {
+	_sortOrder := string(sortOrder)
+	(*call).SortOrder = &_sortOrder
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetStartTime(startTime time.Time) *SearchRecentTweetsCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetTweetFields(tweetFields ...TweetField) *SearchRecentTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) AddTweetFields(tweetFields ...TweetField) *SearchRecentTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetUntilID(untilID string) *SearchRecentTweetsCall {
+	(*call).UntilID = &untilID
+
+	return call
+}
+
+func (call *SearchRecentTweetsCall) SetUserFields(userFields ...UserField) *SearchRecentTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) AddUserFields(userFields ...UserField) *SearchRecentTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchRecentTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	if call.Query == "" {
+		return nil, nil, errortools.ErrorMessage("No Query specified")
+	}
+
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/search/recent%s", *params)
+
+		searchResponse := SearchTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &searchResponse,
+		}
+
+		endpoint := "tweets_search_recent"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if searchResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *searchResponse.Errors))
+		}
+
+		if searchResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *searchResponse.Data...)
+		includes.Append(searchResponse.Includes)
+
+		if searchResponse.Meta == nil {
+			break
+		}
+
+		if searchResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.NextToken = searchResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
+
+type SearchAllTweetsCall struct {
+	service     *Service
+	Query       string     `tw:"query"`
+	EndTime     *time.Time `tw:"end_time"`
+	Expansions  *[]string  `tw:"expansions"`
+	MaxResults  *int       `tw:"max_results"`
+	MediaFields *[]string  `tw:"media.fields"`
+	NextToken   *string    `tw:"next_token"`
+	PlaceFields *[]string  `tw:"place.fields"`
+	PollFields  *[]string  `tw:"poll.fields"`
+	SinceID     *string    `tw:"since_id"`
+	SortOrder   *string    `tw:"sort_order"`
+	StartTime   *time.Time `tw:"start_time"`
+	TweetFields *[]string  `tw:"tweet.fields"`
+	UntilID     *string    `tw:"until_id"`
+	UserFields  *[]string  `tw:"user.fields"`
+}
+
+func (service *Service) NewSearchAllTweetsCall(query string) *SearchAllTweetsCall {
+	return &SearchAllTweetsCall{
+		service: service,
+		Query:   query,
+	}
+}
+
+func (call *SearchAllTweetsCall) SetEndTime(endTime time.Time) *SearchAllTweetsCall {
+	(*call).EndTime = &endTime
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetExpansions(expansions ...TweetExpansion) *SearchAllTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetMaxResults(maxResults int) *SearchAllTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetMediaFields(mediaFields ...MediaField) *SearchAllTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) AddMediaFields(mediaFields ...MediaField) *SearchAllTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetNextToken(nextToken string) *SearchAllTweetsCall {
+	(*call).NextToken = &nextToken
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetPlaceFields(placeFields ...PlaceField) *SearchAllTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) AddPlaceFields(placeFields ...PlaceField) *SearchAllTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetPollFields(pollFields ...PollField) *SearchAllTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) AddPollFields(pollFields ...PollField) *SearchAllTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetSinceID(sinceID string) *SearchAllTweetsCall {
+	(*call).SinceID = &sinceID
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetSortOrder(sortOrder SortOrder) *SearchAllTweetsCall {
+	_sortOrder := string(sortOrder)
+	(*call).SortOrder = &_sortOrder
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetStartTime(startTime time.Time) *SearchAllTweetsCall {
+	(*call).StartTime = &startTime
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetTweetFields(tweetFields ...TweetField) *SearchAllTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) AddTweetFields(tweetFields ...TweetField) *SearchAllTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetUntilID(untilID string) *SearchAllTweetsCall {
+	(*call).UntilID = &untilID
+
+	return call
+}
+
+func (call *SearchAllTweetsCall) SetUserFields(userFields ...UserField) *SearchAllTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) AddUserFields(userFields ...UserField) *SearchAllTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchAllTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	if call.Query == "" {
+		return nil, nil, errortools.ErrorMessage("No Query specified")
+	}
+
+	tweets := []models.Tweet{}
+	includes := models.Includes{
+		Tweets: &[]models.Tweet{},
+		Users:  &[]models.User{},
+		Places: &[]models.Place{},
+		Media:  &[]models.Media{},
+		Polls:  &[]models.Poll{},
+	}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("tweets/search/all%s", *params)
+
+		searchResponse := SearchTweetsResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &searchResponse,
+		}
+
+		endpoint := "tweets_search_all"
+		call.service.rateLimitService.Check(endpoint)
+		call.service.pace(endpoint, searchAllTweetsInterval)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if searchResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *searchResponse.Errors))
+		}
+
+		if searchResponse.Data == nil {
+			break
+		}
+
+		tweets = append(tweets, *searchResponse.Data...)
+		includes.Append(searchResponse.Includes)
+
+		if searchResponse.Meta == nil {
+			break
+		}
+
+		if searchResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.NextToken = searchResponse.Meta.NextToken
+	}
+
+	return &tweets, &includes, nil
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dghubble/oauth1"
@@ -18,6 +19,7 @@ import (
 	oauth2 "github.com/leapforce-libraries/go_oauth2"
 	tokenfixed "github.com/leapforce-libraries/go_oauth2/tokenfixed"
 	ratelimit "github.com/leapforce-libraries/go_ratelimit"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
 	utilities "github.com/leapforce-libraries/go_utilities"
 )
 
@@ -25,6 +27,7 @@ const (
 	apiName                string = "Twitter"
 	apiUrl                 string = "https://api.twitter.com/2"
 	apiUrlV1               string = "https://api.twitter.com/1.1"
+	apiUrlUploadV1         string = "https://upload.twitter.com/1.1"
 	accessTokenUrl2        string = "https://api.twitter.com/oauth2/token?grant_type=client_credentials"
 	authorizeUrl           string = "https://api.twitter.com/2/oauth2/authorize"
 	requestTokenUrl        string = "https://api.twitter.com/oauth/request_token"
@@ -55,6 +58,9 @@ type Service struct {
 	oauthVerifier    string
 	accessToken      string
 	accessSecret     string
+	userContext      bool
+	lastRequests     map[string]time.Time
+	lastRequestsLock sync.Mutex
 }
 
 type ServiceConfigOAuth1 struct {
@@ -127,6 +133,7 @@ func NewServiceOAuth1(serviceConfig *ServiceConfigOAuth1) (*Service, *errortools
 		consumerKey:      serviceConfig.ConsumerKey,
 		httpService:      httpService,
 		rateLimitService: ratelimit.NewService(&rateLimitServiceConfig),
+		userContext:      true,
 	}, nil
 }
 
@@ -158,8 +165,16 @@ func NewServiceOAuth2(serviceConfig ServiceConfigOAuth2) (*Service, *errortools.
 		return nil, e
 	}
 
+	headerRemaining := "x-rate-limit-remaining"
+	headerReset := "x-rate-limit-reset"
+	rateLimitServiceConfig := ratelimit.ServiceConfig{
+		HeaderRemaining: &headerRemaining,
+		HeaderReset:     &headerReset,
+	}
+
 	return &Service{
-		oAuth2Service: oAuth2Service,
+		oAuth2Service:    oAuth2Service,
+		rateLimitService: ratelimit.NewService(&rateLimitServiceConfig),
 	}, nil
 }
 
@@ -203,6 +218,21 @@ func (service *Service) get(requestConfig *go_http.RequestConfig) (*http.Request
 	return service.httpRequest(http.MethodGet, requestConfig)
 }
 
+// generic Post method
+func (service *Service) post(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodPost, requestConfig)
+}
+
+// generic Put method
+func (service *Service) put(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodPut, requestConfig)
+}
+
+// generic Delete method
+func (service *Service) delete(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodDelete, requestConfig)
+}
+
 func (service *Service) url(path string) string {
 	return fmt.Sprintf("%s/%s", apiUrl, path)
 }
@@ -211,8 +241,24 @@ func (service *Service) urlV1(path string) string {
 	return fmt.Sprintf("%s/%s", apiUrlV1, path)
 }
 
+func (service *Service) urlUploadV1(path string) string {
+	return fmt.Sprintf("%s/%s", apiUrlUploadV1, path)
+}
+
 func (service *Service) httpRequest(httpMethod string, requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	request, response, e := service.doHttpRequest(httpMethod, requestConfig)
+
+	if waitForRateLimitReset(response) {
+		return service.httpRequest(httpMethod, requestConfig)
+	}
+
+	return request, response, e
+}
+
+// doHttpRequest sends a single request, without waiting for the rate limit to reset
+func (service *Service) doHttpRequest(httpMethod string, requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
 	errorResponse := ErrorResponse{}
+	(*requestConfig).Method = httpMethod
 	(*requestConfig).ErrorModel = &errorResponse
 
 	var request *http.Request = nil
@@ -225,37 +271,105 @@ func (service *Service) httpRequest(httpMethod string, requestConfig *go_http.Re
 		request, response, e = service.oAuth2Service.HttpRequest(requestConfig)
 	}
 
-	if response != nil {
-		if response.StatusCode == 429 {
-			// handle rate limit
-			rateLimitReset, err := strconv.ParseInt(response.Header.Get("x-rate-limit-reset"), 10, 64)
-			if err == nil {
-				rateLimitResetUnix := time.Unix(rateLimitReset, 0)
-				duration := time.Until(rateLimitResetUnix)
+	setErrorResponse(e, &errorResponse)
 
-				if duration > 0 {
-					errortools.CaptureInfo(fmt.Sprintf("Rate limit exceeded, waiting %v ms.", duration.Milliseconds()))
-					time.Sleep(duration)
+	return request, response, e
+}
 
-					return service.httpRequest(httpMethod, requestConfig)
-				}
-			}
-		}
+// waitForRateLimitReset sleeps until the rate limit resets if response reports it was exceeded,
+// it returns true if the request should be retried
+func waitForRateLimitReset(response *http.Response) bool {
+	if response == nil {
+		return false
 	}
 
-	if e != nil {
-		if errorResponse.Detail != "" {
-			e.SetMessage(errorResponse.Detail)
-		}
+	if response.StatusCode != 429 {
+		return false
+	}
 
-		b, _ := json.Marshal(errorResponse)
-		e.SetExtra("error", string(b))
+	rateLimitReset, err := strconv.ParseInt(response.Header.Get("x-rate-limit-reset"), 10, 64)
+	if err != nil {
+		return false
 	}
 
-	return request, response, e
+	duration := time.Until(time.Unix(rateLimitReset, 0))
+	if duration <= 0 {
+		return false
+	}
+
+	errortools.CaptureInfo(fmt.Sprintf("Rate limit exceeded, waiting %v ms.", duration.Milliseconds()))
+	time.Sleep(duration)
+
+	return true
+}
+
+func setErrorResponse(e *errortools.Error, errorResponse *ErrorResponse) {
+	if e == nil {
+		return
+	}
+
+	if errorResponse.Detail != "" {
+		e.SetMessage(errorResponse.Detail)
+	}
+
+	b, _ := json.Marshal(errorResponse)
+	e.SetExtra("error", string(b))
+}
+
+// requireUserContext returns an error if the Service does not act on behalf of a user
+func (service *Service) requireUserContext(operation string) *errortools.Error {
+	if !service.userContext {
+		return errortools.ErrorMessagef("%s requires user context authentication, create the Service with NewServiceOAuth1", operation)
+	}
+
+	return nil
+}
+
+// pace waits until at least interval has passed since the previous request to endpoint,
+// the lock is only held to reserve the slot so that other endpoints are not delayed
+func (service *Service) pace(endpoint string, interval time.Duration) {
+	service.lastRequestsLock.Lock()
+
+	if service.lastRequests == nil {
+		service.lastRequests = make(map[string]time.Time)
+	}
+
+	next := time.Now()
+
+	lastRequest, ok := service.lastRequests[endpoint]
+	if ok && lastRequest.Add(interval).After(next) {
+		next = lastRequest.Add(interval)
+	}
+
+	service.lastRequests[endpoint] = next
+
+	service.lastRequestsLock.Unlock()
+
+	time.Sleep(time.Until(next))
+}
+
+// errorsFound returns an error holding the errors found in a response body
+func errorsFound(request *http.Request, response *http.Response, errors []models.Error) *errortools.Error {
+	e := new(errortools.Error)
+	e.SetRequest(request)
+	e.SetResponse(response)
+
+	b, err := json.Marshal(errors)
+	if err == nil {
+		e.SetExtra("errors", string(b))
+	}
+
+	e.SetMessage(fmt.Sprintf("%v errors found", len(errors)))
+
+	return e
 }
 
 func (service *Service) urlParams(model interface{}) (*string, *errortools.Error) {
+	return urlParams(model)
+}
+
+// urlParams builds the query string from the fields of model tagged with tw
+func urlParams(model interface{}) (*string, *errortools.Error) {
 	if utilities.IsNil(model) {
 		return nil, nil
 	}
@@ -126,6 +126,15 @@ func NewService2OAuth2(serviceConfig *Service2ConfigOAuth2) (*Service2, *errorto
 
 	service.oAuth2Service = oAuth2Service
 
+	headerRemaining := "x-rate-limit-remaining"
+	headerReset := "x-rate-limit-reset"
+	rateLimitServiceConfig := ratelimit.ServiceConfig{
+		HeaderRemaining: &headerRemaining,
+		HeaderReset:     &headerReset,
+	}
+
+	service.rateLimitService = ratelimit.NewService(&rateLimitServiceConfig)
+
 	return &service, nil
 }
 func (service *Service2) AuthorizeUrl(scope string, state string) string {
@@ -142,3 +151,42 @@ func (service *Service2) ValidateToken() {
 func (service *Service2) GetTokenFromCode(r *http.Request, checkState *func(state string) *errortools.Error) *errortools.Error {
 	return service.oAuth2Service.GetTokenFromCode(r, checkState)
 }
+
+// generic Get method
+func (service *Service2) get(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodGet, requestConfig)
+}
+
+// generic Post method
+func (service *Service2) post(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodPost, requestConfig)
+}
+
+// generic Delete method
+func (service *Service2) delete(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	return service.httpRequest(http.MethodDelete, requestConfig)
+}
+
+func (service *Service2) url(path string) string {
+	return fmt.Sprintf("%s/%s", apiUrl, path)
+}
+
+func (service *Service2) urlParams(model interface{}) (*string, *errortools.Error) {
+	return urlParams(model)
+}
+
+func (service *Service2) httpRequest(httpMethod string, requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	errorResponse := ErrorResponse{}
+	(*requestConfig).Method = httpMethod
+	(*requestConfig).ErrorModel = &errorResponse
+
+	request, response, e := service.oAuth2Service.HttpRequest(requestConfig)
+
+	if waitForRateLimitReset(response) {
+		return service.httpRequest(httpMethod, requestConfig)
+	}
+
+	setErrorResponse(e, &errorResponse)
+
+	return request, response, e
+}
@@ -0,0 +1,918 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+const (
+	maximumNumberOfSpaceIDsPerCall int = 100
+)
+
+type SpaceResponse struct {
+	Data     *models.Space    `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type SpacesResponse struct {
+	Data     *[]models.Space  `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Meta     *models.Meta     `json:"meta"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
+type SpaceExpansion string
+
+const (
+	SpaceExpansionCreatorID      SpaceExpansion = "creator_id"
+	SpaceExpansionHostIDs        SpaceExpansion = "host_ids"
+	SpaceExpansionInvitedUserIDs SpaceExpansion = "invited_user_ids"
+	SpaceExpansionSpeakerIDs     SpaceExpansion = "speaker_ids"
+	SpaceExpansionTopicIDs       SpaceExpansion = "topic_ids"
+)
+
+type SpaceField string
+
+const (
+	SpaceFieldCreatedAt        SpaceField = "created_at"
+	SpaceFieldCreatorID        SpaceField = "creator_id"
+	SpaceFieldEndedAt          SpaceField = "ended_at"
+	SpaceFieldHostIDs          SpaceField = "host_ids"
+	SpaceFieldID               SpaceField = "id"
+	SpaceFieldInvitedUserIDs   SpaceField = "invited_user_ids"
+	SpaceFieldIsTicketed       SpaceField = "is_ticketed"
+	SpaceFieldLanguage         SpaceField = "lang"
+	SpaceFieldParticipantCount SpaceField = "participant_count"
+	SpaceFieldScheduledStart   SpaceField = "scheduled_start"
+	SpaceFieldSpeakerIDs       SpaceField = "speaker_ids"
+	SpaceFieldStartedAt        SpaceField = "started_at"
+	SpaceFieldState            SpaceField = "state"
+	SpaceFieldSubscriberCount  SpaceField = "subscriber_count"
+	SpaceFieldTitle            SpaceField = "title"
+	SpaceFieldTopicIDs         SpaceField = "topic_ids"
+	SpaceFieldUpdatedAt        SpaceField = "updated_at"
+)
+
+type TopicField string
+
+const (
+	TopicFieldDescription TopicField = "description"
+	TopicFieldID          TopicField = "id"
+	TopicFieldName        TopicField = "name"
+)
+
+type SpaceState string
+
+const (
+	SpaceStateAll       SpaceState = "all"
+	SpaceStateLive      SpaceState = "live"
+	SpaceStateScheduled SpaceState = "scheduled"
+)
+
+func setSpaceFields(add bool, spaceFields *[]string, setSpaceFields []SpaceField) {
+	elems := []string{}
+
+	if spaceFields != nil && add {
+		elems = *spaceFields
+	}
+
+	for _, spaceField := range setSpaceFields {
+		for _, _elem := range elems {
+			if _elem == string(spaceField) {
+				goto next
+			}
+		}
+		elems = append(elems, string(spaceField))
+	next:
+	}
+	(*spaceFields) = elems
+}
+
+func setTopicFields(add bool, topicFields *[]string, setTopicFields []TopicField) {
+	elems := []string{}
+
+	if topicFields != nil && add {
+		elems = *topicFields
+	}
+
+	for _, topicField := range setTopicFields {
+		for _, _elem := range elems {
+			if _elem == string(topicField) {
+				goto next
+			}
+		}
+		elems = append(elems, string(topicField))
+	next:
+	}
+	(*topicFields) = elems
+}
+
+type GetSpaceCall struct {
+	service     *Service
+	spaceID     string
+	Expansions  *[]string `tw:"expansions"`
+	SpaceFields *[]string `tw:"space.fields"`
+	TopicFields *[]string `tw:"topic.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetSpaceCall(spaceID string) *GetSpaceCall {
+	return &GetSpaceCall{
+		service: service,
+		spaceID: spaceID,
+	}
+}
+
+func (call *GetSpaceCall) SetExpansions(expansions ...SpaceExpansion) *GetSpaceCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSpaceCall) SetSpaceFields(spaceFields ...SpaceField) *GetSpaceCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(false, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpaceCall) AddSpaceFields(spaceFields ...SpaceField) *GetSpaceCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(true, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpaceCall) SetTopicFields(topicFields ...TopicField) *GetSpaceCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(false, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpaceCall) AddTopicFields(topicFields ...TopicField) *GetSpaceCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(true, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpaceCall) SetUserFields(userFields ...UserField) *GetSpaceCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceCall) AddUserFields(userFields ...UserField) *GetSpaceCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceCall) Do() (*models.Space, *models.Includes, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("spaces/%s%s", call.spaceID, *params)
+
+	spaceResponse := SpaceResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &spaceResponse,
+	}
+
+	endpoint := "spaces_id"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if spaceResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *spaceResponse.Errors))
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	return spaceResponse.Data, spaceResponse.Includes, nil
+}
+
+type GetSpacesCall struct {
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	IDs         []string  `tw:"ids"`
+	SpaceFields *[]string `tw:"space.fields"`
+	TopicFields *[]string `tw:"topic.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+// NewGetSpacesCall looks up spaces by id, in batches of 100
+func (service *Service) NewGetSpacesCall(ids []string) *GetSpacesCall {
+	return &GetSpacesCall{
+		service: service,
+		IDs:     ids,
+	}
+}
+
+func (call *GetSpacesCall) SetExpansions(expansions ...SpaceExpansion) *GetSpacesCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSpacesCall) SetSpaceFields(spaceFields ...SpaceField) *GetSpacesCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(false, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpacesCall) AddSpaceFields(spaceFields ...SpaceField) *GetSpacesCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(true, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpacesCall) SetTopicFields(topicFields ...TopicField) *GetSpacesCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(false, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpacesCall) AddTopicFields(topicFields ...TopicField) *GetSpacesCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(true, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpacesCall) SetUserFields(userFields ...UserField) *GetSpacesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpacesCall) AddUserFields(userFields ...UserField) *GetSpacesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpacesCall) Do() (*[]models.Space, *models.Includes, *[]string, *errortools.Error) {
+	if len(call.IDs) == 0 {
+		return nil, nil, nil, errortools.ErrorMessage("No SpaceIDs specified")
+	}
+
+	spaces := []models.Space{}
+	includes := models.Includes{}
+	notFound := []string{}
+
+	ids := call.IDs
+	defer func() {
+		call.IDs = ids
+	}()
+
+	remaining := ids
+	for {
+		batch := remaining
+		if len(remaining) > maximumNumberOfSpaceIDsPerCall {
+			batch = remaining[:maximumNumberOfSpaceIDsPerCall]
+		}
+
+		call.IDs = batch
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("spaces%s", *params)
+
+		spacesResponse := SpacesResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &spacesResponse,
+		}
+
+		endpoint := "spaces"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		var errors []models.Error
+
+		if spacesResponse.Errors != nil {
+			for _, spaceError := range *spacesResponse.Errors {
+				if spaceError.Title == "Not Found Error" {
+					notFound = append(notFound, spaceError.Value)
+				} else {
+					errors = append(errors, spaceError)
+				}
+			}
+
+			if len(errors) > 0 {
+				errortools.CaptureError(errorsFound(request, response, errors))
+			}
+		}
+
+		if spacesResponse.Data != nil {
+			spaces = append(spaces, (*spacesResponse.Data)...)
+		}
+
+		includes.Append(spacesResponse.Includes)
+
+		if len(remaining) <= maximumNumberOfSpaceIDsPerCall {
+			break
+		}
+
+		remaining = remaining[maximumNumberOfSpaceIDsPerCall:]
+	}
+
+	return &spaces, &includes, &notFound, nil
+}
+
+type GetSpacesByCreatorIDsCall struct {
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	UserIDs     []string  `tw:"user_ids"`
+	SpaceFields *[]string `tw:"space.fields"`
+	TopicFields *[]string `tw:"topic.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+// NewGetSpacesByCreatorIDsCall looks up the spaces created by userIDs, in batches of 100
+func (service *Service) NewGetSpacesByCreatorIDsCall(userIDs []string) *GetSpacesByCreatorIDsCall {
+	return &GetSpacesByCreatorIDsCall{
+		service: service,
+		UserIDs: userIDs,
+	}
+}
+
+func (call *GetSpacesByCreatorIDsCall) SetExpansions(expansions ...SpaceExpansion) *GetSpacesByCreatorIDsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) SetSpaceFields(spaceFields ...SpaceField) *GetSpacesByCreatorIDsCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(false, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) AddSpaceFields(spaceFields ...SpaceField) *GetSpacesByCreatorIDsCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(true, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) SetTopicFields(topicFields ...TopicField) *GetSpacesByCreatorIDsCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(false, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) AddTopicFields(topicFields ...TopicField) *GetSpacesByCreatorIDsCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(true, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) SetUserFields(userFields ...UserField) *GetSpacesByCreatorIDsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) AddUserFields(userFields ...UserField) *GetSpacesByCreatorIDsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpacesByCreatorIDsCall) Do() (*[]models.Space, *models.Includes, *errortools.Error) {
+	if len(call.UserIDs) == 0 {
+		return nil, nil, errortools.ErrorMessage("No UserIDs specified")
+	}
+
+	spaces := []models.Space{}
+	includes := models.Includes{}
+
+	userIDs := call.UserIDs
+	defer func() {
+		call.UserIDs = userIDs
+	}()
+
+	remaining := userIDs
+	for {
+		batch := remaining
+		if len(remaining) > maximumNumberOfSpaceIDsPerCall {
+			batch = remaining[:maximumNumberOfSpaceIDsPerCall]
+		}
+
+		call.UserIDs = batch
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("spaces/by/creator_ids%s", *params)
+
+		spacesResponse := SpacesResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &spacesResponse,
+		}
+
+		endpoint := "spaces_by_creator_ids"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if spacesResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *spacesResponse.Errors))
+		}
+
+		if spacesResponse.Data != nil {
+			spaces = append(spaces, (*spacesResponse.Data)...)
+		}
+
+		includes.Append(spacesResponse.Includes)
+
+		if len(remaining) <= maximumNumberOfSpaceIDsPerCall {
+			break
+		}
+
+		remaining = remaining[maximumNumberOfSpaceIDsPerCall:]
+	}
+
+	return &spaces, &includes, nil
+}
+
+type SearchSpacesCall struct {
+	service     *Service
+	Query       string    `tw:"query"`
+	Expansions  *[]string `tw:"expansions"`
+	MaxResults  *int      `tw:"max_results"`
+	SpaceFields *[]string `tw:"space.fields"`
+	State       *string   `tw:"state"`
+	TopicFields *[]string `tw:"topic.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewSearchSpacesCall(query string) *SearchSpacesCall {
+	return &SearchSpacesCall{
+		service: service,
+		Query:   query,
+	}
+}
+
+func (call *SearchSpacesCall) SetExpansions(expansions ...SpaceExpansion) *SearchSpacesCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *SearchSpacesCall) SetMaxResults(maxResults int) *SearchSpacesCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *SearchSpacesCall) SetSpaceFields(spaceFields ...SpaceField) *SearchSpacesCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(false, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *SearchSpacesCall) AddSpaceFields(spaceFields ...SpaceField) *SearchSpacesCall {
+	if call.SpaceFields == nil {
+		call.SpaceFields = &[]string{}
+	}
+	setSpaceFields(true, call.SpaceFields, spaceFields)
+	return call
+}
+
+func (call *SearchSpacesCall) SetState(state SpaceState) *SearchSpacesCall {
+	_state := string(state)
+	(*call).State = &_state
+
+	return call
+}
+
+func (call *SearchSpacesCall) SetTopicFields(topicFields ...TopicField) *SearchSpacesCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(false, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *SearchSpacesCall) AddTopicFields(topicFields ...TopicField) *SearchSpacesCall {
+	if call.TopicFields == nil {
+		call.TopicFields = &[]string{}
+	}
+	setTopicFields(true, call.TopicFields, topicFields)
+	return call
+}
+
+func (call *SearchSpacesCall) SetUserFields(userFields ...UserField) *SearchSpacesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchSpacesCall) AddUserFields(userFields ...UserField) *SearchSpacesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *SearchSpacesCall) Do() (*[]models.Space, *models.Includes, *errortools.Error) {
+	if call.Query == "" {
+		return nil, nil, errortools.ErrorMessage("No Query specified")
+	}
+
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("spaces/search%s", *params)
+
+	spacesResponse := SpacesResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &spacesResponse,
+	}
+
+	endpoint := "spaces_search"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	if spacesResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *spacesResponse.Errors))
+	}
+
+	if spacesResponse.Data == nil {
+		return &[]models.Space{}, spacesResponse.Includes, nil
+	}
+
+	return spacesResponse.Data, spacesResponse.Includes, nil
+}
+
+type GetSpaceTweetsCall struct {
+	service     *Service
+	spaceID     string
+	Expansions  *[]string `tw:"expansions"`
+	MaxResults  *int      `tw:"max_results"`
+	MediaFields *[]string `tw:"media.fields"`
+	PlaceFields *[]string `tw:"place.fields"`
+	PollFields  *[]string `tw:"poll.fields"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetSpaceTweetsCall(spaceID string) *GetSpaceTweetsCall {
+	return &GetSpaceTweetsCall{
+		service: service,
+		spaceID: spaceID,
+	}
+}
+
+func (call *GetSpaceTweetsCall) SetExpansions(expansions ...TweetExpansion) *GetSpaceTweetsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetMaxResults(maxResults int) *GetSpaceTweetsCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetMediaFields(mediaFields ...MediaField) *GetSpaceTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(false, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) AddMediaFields(mediaFields ...MediaField) *GetSpaceTweetsCall {
+	if call.MediaFields == nil {
+		call.MediaFields = &[]string{}
+	}
+	setMediaFields(true, call.MediaFields, mediaFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetPlaceFields(placeFields ...PlaceField) *GetSpaceTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(false, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) AddPlaceFields(placeFields ...PlaceField) *GetSpaceTweetsCall {
+	if call.PlaceFields == nil {
+		call.PlaceFields = &[]string{}
+	}
+	setPlaceFields(true, call.PlaceFields, placeFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetPollFields(pollFields ...PollField) *GetSpaceTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(false, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) AddPollFields(pollFields ...PollField) *GetSpaceTweetsCall {
+	if call.PollFields == nil {
+		call.PollFields = &[]string{}
+	}
+	setPollFields(true, call.PollFields, pollFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetTweetFields(tweetFields ...TweetField) *GetSpaceTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) AddTweetFields(tweetFields ...TweetField) *GetSpaceTweetsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) SetUserFields(userFields ...UserField) *GetSpaceTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) AddUserFields(userFields ...UserField) *GetSpaceTweetsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceTweetsCall) Do() (*[]models.Tweet, *models.Includes, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("spaces/%s/tweets%s", call.spaceID, *params)
+
+	spaceTweetsResponse := UserTweetsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &spaceTweetsResponse,
+	}
+
+	endpoint := "spaces_tweets"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	if spaceTweetsResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *spaceTweetsResponse.Errors))
+	}
+
+	if spaceTweetsResponse.Data == nil {
+		return &[]models.Tweet{}, spaceTweetsResponse.Includes, nil
+	}
+
+	return spaceTweetsResponse.Data, spaceTweetsResponse.Includes, nil
+}
+
+type GetSpaceBuyersCall struct {
+	service         *Service2
+	spaceID         string
+	Expansions      *[]string `tw:"expansions"`
+	MaxResults      *int      `tw:"max_results"`
+	PaginationToken *string   `tw:"pagination_token"`
+	TweetFields     *[]string `tw:"tweet.fields"`
+	UserFields      *[]string `tw:"user.fields"`
+}
+
+// NewGetSpaceBuyersCall returns the users who bought a ticket to spaceID, which must be created by the authenticated user,
+// the endpoint requires OAuth 2.0 user context, hence the call is only available on Service2
+func (service *Service2) NewGetSpaceBuyersCall(spaceID string) *GetSpaceBuyersCall {
+	return &GetSpaceBuyersCall{
+		service: service,
+		spaceID: spaceID,
+	}
+}
+
+func (call *GetSpaceBuyersCall) SetExpansions(expansions ...UserExpansion) *GetSpaceBuyersCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetSpaceBuyersCall) SetMaxResults(maxResults int) *GetSpaceBuyersCall {
+	(*call).MaxResults = &maxResults
+
+	return call
+}
+
+func (call *GetSpaceBuyersCall) SetPaginationToken(paginationToken string) *GetSpaceBuyersCall {
+	(*call).PaginationToken = &paginationToken
+
+	return call
+}
+
+func (call *GetSpaceBuyersCall) SetTweetFields(tweetFields ...TweetField) *GetSpaceBuyersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSpaceBuyersCall) AddTweetFields(tweetFields ...TweetField) *GetSpaceBuyersCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetSpaceBuyersCall) SetUserFields(userFields ...UserField) *GetSpaceBuyersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceBuyersCall) AddUserFields(userFields ...UserField) *GetSpaceBuyersCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetSpaceBuyersCall) Do() (*[]models.User, *models.Includes, *errortools.Error) {
+	users := []models.User{}
+	includes := models.Includes{}
+
+	for {
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("spaces/%s/buyers%s", call.spaceID, *params)
+
+		spaceBuyersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &spaceBuyersResponse,
+		}
+
+		endpoint := "spaces_buyers"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		if spaceBuyersResponse.Errors != nil {
+			errortools.CaptureError(errorsFound(request, response, *spaceBuyersResponse.Errors))
+		}
+
+		if spaceBuyersResponse.Data == nil {
+			break
+		}
+
+		users = append(users, *spaceBuyersResponse.Data...)
+		includes.Append(spaceBuyersResponse.Includes)
+
+		if spaceBuyersResponse.Meta == nil {
+			break
+		}
+
+		if spaceBuyersResponse.Meta.NextToken == nil {
+			break
+		}
+
+		call.PaginationToken = spaceBuyersResponse.Meta.NextToken
+	}
+
+	return &users, &includes, nil
+}
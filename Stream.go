@@ -0,0 +1,234 @@
+package twitter
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// reconnect backoff as recommended by Twitter, see:
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/integrate/handling-disconnections
+const (
+	streamNetworkBackoffStep  time.Duration = 250 * time.Millisecond
+	streamNetworkBackoffMax   time.Duration = 16 * time.Second
+	streamHttpBackoffMin      time.Duration = 5 * time.Second
+	streamHttpBackoffMax      time.Duration = 320 * time.Second
+	streamRateLimitBackoffMin time.Duration = time.Minute
+	streamRateLimitBackoffMax time.Duration = 15 * time.Minute
+	streamStallTimeout        time.Duration = 20 * time.Second
+)
+
+// streamer holds the state shared by all streaming calls
+type streamer struct {
+	lock     sync.Mutex
+	stopChan chan struct{}
+	body     io.ReadCloser
+	// stallTimeout overrides streamStallTimeout if set
+	stallTimeout time.Duration
+}
+
+func (streamer *streamer) stopped() chan struct{} {
+	streamer.lock.Lock()
+	defer streamer.lock.Unlock()
+
+	if streamer.stopChan == nil {
+		streamer.stopChan = make(chan struct{})
+	}
+
+	return streamer.stopChan
+}
+
+func (streamer *streamer) isStopped() bool {
+	select {
+	case <-streamer.stopped():
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop disconnects the stream, after which Do returns
+func (streamer *streamer) Stop() {
+	stopChan := streamer.stopped()
+
+	streamer.lock.Lock()
+	defer streamer.lock.Unlock()
+
+	select {
+	case <-stopChan:
+	default:
+		close(stopChan)
+	}
+
+	if streamer.body != nil {
+		streamer.body.Close()
+	}
+}
+
+// wait sleeps for duration and returns false if the stream was stopped in the meantime
+func (streamer *streamer) wait(duration time.Duration) bool {
+	select {
+	case <-streamer.stopped():
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
+
+func (streamer *streamer) setBody(body io.ReadCloser) bool {
+	streamer.lock.Lock()
+	defer streamer.lock.Unlock()
+
+	streamer.body = body
+
+	select {
+	case <-streamer.stopChan:
+		body.Close()
+		return false
+	default:
+		return true
+	}
+}
+
+// read passes every non-empty line of body to handle until body is closed or stalls,
+// it returns whether any data, keep-alive newlines included, was received
+func (streamer *streamer) read(body io.ReadCloser, handle func(line []byte)) bool {
+	defer body.Close()
+
+	if !streamer.setBody(body) {
+		return false
+	}
+
+	stallTimeout := streamStallTimeout
+	if streamer.stallTimeout > 0 {
+		stallTimeout = streamer.stallTimeout
+	}
+
+	stall := time.AfterFunc(stallTimeout, func() { body.Close() })
+	defer stall.Stop()
+
+	received := false
+	reader := bufio.NewReader(body)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			received = true
+			stall.Reset(stallTimeout)
+
+			line = bytes.TrimSpace(line)
+			if len(line) > 0 {
+				handle(line)
+			}
+		}
+
+		if err != nil {
+			return received
+		}
+	}
+}
+
+// streamUrl returns the url to connect to, reconnect is false for the first connection only
+type streamUrl func(reconnect bool) (string, *errortools.Error)
+
+// fixedStreamUrl returns a streamUrl that connects to url every time
+func fixedStreamUrl(url string) streamUrl {
+	return func(reconnect bool) (string, *errortools.Error) {
+		return url, nil
+	}
+}
+
+// stream connects to the url returned by url and passes every line received to handle,
+// it reconnects with backoff until Stop is called or a non-recoverable error is returned
+func (service *Service) stream(url streamUrl, streamer *streamer, handle func(line []byte)) *errortools.Error {
+	var networkBackoff time.Duration = 0
+	var httpBackoff time.Duration = 0
+	var rateLimitBackoff time.Duration = 0
+
+	var maxRetries uint = 0
+
+	for reconnect := false; !streamer.isStopped(); reconnect = true {
+		_url, e := url(reconnect)
+		if e != nil {
+			return e
+		}
+
+		requestConfig := go_http.RequestConfig{
+			Url:        _url,
+			MaxRetries: &maxRetries,
+		}
+
+		// rate limits are handled by the backoff below, which unlike waiting for the reset can be interrupted by Stop
+		request, response, e := service.doHttpRequest(http.MethodGet, &requestConfig)
+
+		var backoff time.Duration
+
+		if request == nil && e != nil {
+			// the request could not be created, e.g. because the token is invalid, reconnecting does not resolve this
+			return e
+		} else if response == nil {
+			// linear backoff for network errors
+			networkBackoff += streamNetworkBackoffStep
+			if networkBackoff > streamNetworkBackoffMax {
+				networkBackoff = streamNetworkBackoffMax
+			}
+			backoff = networkBackoff
+		} else if e != nil {
+			switch {
+			case response.StatusCode == http.StatusTooManyRequests:
+				// exponential backoff, starting at one minute, for rate limit errors
+				if rateLimitBackoff == 0 {
+					rateLimitBackoff = streamRateLimitBackoffMin
+				} else if rateLimitBackoff < streamRateLimitBackoffMax {
+					rateLimitBackoff *= 2
+				}
+				backoff = rateLimitBackoff
+			case response.StatusCode == 420 || response.StatusCode >= 500:
+				// exponential backoff for http errors
+				if httpBackoff == 0 {
+					httpBackoff = streamHttpBackoffMin
+				} else if httpBackoff < streamHttpBackoffMax {
+					httpBackoff *= 2
+				}
+				backoff = httpBackoff
+			default:
+				// reconnecting does not resolve client errors
+				return e
+			}
+		} else {
+			received := streamer.read(response.Body, handle)
+			if received {
+				networkBackoff = 0
+				httpBackoff = 0
+				rateLimitBackoff = 0
+			}
+
+			if streamer.isStopped() {
+				break
+			}
+
+			// stream was disconnected or stalled
+			networkBackoff += streamNetworkBackoffStep
+			if networkBackoff > streamNetworkBackoffMax {
+				networkBackoff = streamNetworkBackoffMax
+			}
+			backoff = networkBackoff
+		}
+
+		if e != nil {
+			errortools.CaptureInfo(e.Message())
+		}
+
+		if !streamer.wait(backoff) {
+			break
+		}
+	}
+
+	return nil
+}
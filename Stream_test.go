@@ -0,0 +1,327 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+func newTestStreamService(t *testing.T) *Service {
+	httpService, e := go_http.NewService(&go_http.ServiceConfig{})
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	return &Service{
+		httpService: httpService,
+	}
+}
+
+type testStreamLines struct {
+	lock     sync.Mutex
+	lines    []string
+	received chan struct{}
+}
+
+func newTestStreamLines() *testStreamLines {
+	return &testStreamLines{
+		received: make(chan struct{}, 100),
+	}
+}
+
+func (lines *testStreamLines) handle(line []byte) {
+	lines.lock.Lock()
+	lines.lines = append(lines.lines, string(line))
+	lines.lock.Unlock()
+
+	lines.received <- struct{}{}
+}
+
+func (lines *testStreamLines) get() []string {
+	lines.lock.Lock()
+	defer lines.lock.Unlock()
+
+	return append([]string{}, lines.lines...)
+}
+
+func (lines *testStreamLines) waitFor(t *testing.T, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-lines.received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %v lines, expected %v", len(lines.get()), count)
+		}
+	}
+}
+
+func runTestStream(service *Service, url string, streamer *streamer, handle func(line []byte)) chan *errortools.Error {
+	done := make(chan *errortools.Error, 1)
+
+	go func() {
+		done <- service.stream(fixedStreamUrl(url), streamer, handle)
+	}()
+
+	return done
+}
+
+func waitForTestStream(t *testing.T, done chan *errortools.Error, timeout time.Duration) *errortools.Error {
+	select {
+	case e := <-done:
+		return e
+	case <-time.After(timeout):
+		t.Fatalf("stream did not return within %v", timeout)
+		return nil
+	}
+}
+
+// writeAndFlush writes chunks to w, flushing after each of them
+func writeAndFlush(w http.ResponseWriter, chunks ...string) {
+	for _, chunk := range chunks {
+		fmt.Fprint(w, chunk)
+		w.(http.Flusher).Flush()
+	}
+}
+
+// block keeps the connection open until the client disconnects
+func block(r *http.Request) {
+	<-r.Context().Done()
+}
+
+func TestStreamSkipsKeepAliveNewlines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeAndFlush(w, "\r\n", "{\"id\":\"1\"}\r\n", "\r\n", "\r\n", "{\"id\":\"2\"}\r\n")
+		block(r)
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+	lines := newTestStreamLines()
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, lines.handle)
+	lines.waitFor(t, 2)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	got := lines.get()
+	if len(got) != 2 || got[0] != "{\"id\":\"1\"}" || got[1] != "{\"id\":\"2\"}" {
+		t.Fatalf("unexpected lines %q", got)
+	}
+}
+
+func TestStreamReassemblesChunkedLines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeAndFlush(w, "{\"id\":")
+		time.Sleep(50 * time.Millisecond)
+		writeAndFlush(w, "\"1\",\"text\":")
+		time.Sleep(50 * time.Millisecond)
+		writeAndFlush(w, "\"hello\"}\r\n")
+		block(r)
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+	lines := newTestStreamLines()
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, lines.handle)
+	lines.waitFor(t, 1)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	got := lines.get()
+	if len(got) != 1 || got[0] != "{\"id\":\"1\",\"text\":\"hello\"}" {
+		t.Fatalf("unexpected lines %q", got)
+	}
+}
+
+func TestStreamReconnectsAfterDisconnect(t *testing.T) {
+	var connections int32 = 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection := atomic.AddInt32(&connections, 1)
+		writeAndFlush(w, fmt.Sprintf("{\"connection\":%v}\r\n", connection))
+		// returning closes the connection
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+	lines := newTestStreamLines()
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, lines.handle)
+	lines.waitFor(t, 3)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	got := lines.get()
+	for i := 0; i < 3; i++ {
+		expected := fmt.Sprintf("{\"connection\":%v}", i+1)
+		if got[i] != expected {
+			t.Fatalf("line %v is %q, expected %q", i, got[i], expected)
+		}
+	}
+}
+
+func TestStreamReconnectsAfterStall(t *testing.T) {
+	var connections int32 = 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection := atomic.AddInt32(&connections, 1)
+		writeAndFlush(w, fmt.Sprintf("{\"connection\":%v}\r\n", connection))
+		// no data nor keep-alive newlines are sent anymore
+		block(r)
+	}))
+	defer server.Close()
+
+	streamer := streamer{
+		stallTimeout: 100 * time.Millisecond,
+	}
+	lines := newTestStreamLines()
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, lines.handle)
+	lines.waitFor(t, 2)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	if atomic.LoadInt32(&connections) < 2 {
+		t.Fatalf("stream did not reconnect after stalling")
+	}
+}
+
+func TestStreamStopWhileReading(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeAndFlush(w, "{\"id\":\"1\"}\r\n")
+		block(r)
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+	lines := newTestStreamLines()
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, lines.handle)
+	lines.waitFor(t, 1)
+
+	// the stream is now blocked reading the next line
+	time.Sleep(50 * time.Millisecond)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+}
+
+func TestStreamStopWhileBackingOff(t *testing.T) {
+	var connections int32 = 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&connections, 1)
+		w.Header().Set("x-rate-limit-reset", strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, func(line []byte) {})
+
+	// the stream is now backing off for a minute
+	time.Sleep(200 * time.Millisecond)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	if atomic.LoadInt32(&connections) != 1 {
+		t.Fatalf("stream connected %v times, expected 1", atomic.LoadInt32(&connections))
+	}
+}
+
+func TestStreamReturnsClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+
+	done := runTestStream(newTestStreamService(t), server.URL, &streamer, func(line []byte) {})
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestStreamReturnsRequestErrors(t *testing.T) {
+	streamer := streamer{}
+
+	// the request cannot be created
+	done := runTestStream(newTestStreamService(t), "http://[::1]:namedport", &streamer, func(line []byte) {})
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestStreamRequestsUrlPerConnection(t *testing.T) {
+	queries := make(chan string, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.RawQuery
+		writeAndFlush(w, "{\"id\":\"1\"}\r\n")
+	}))
+	defer server.Close()
+
+	streamer := streamer{}
+	lines := newTestStreamLines()
+
+	url := func(reconnect bool) (string, *errortools.Error) {
+		return fmt.Sprintf("%s?reconnect=%v", server.URL, reconnect), nil
+	}
+
+	done := make(chan *errortools.Error, 1)
+	go func() {
+		done <- newTestStreamService(t).stream(url, &streamer, lines.handle)
+	}()
+
+	lines.waitFor(t, 2)
+	streamer.Stop()
+
+	e := waitForTestStream(t, done, 5*time.Second)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	for i, expected := range []string{"reconnect=false", "reconnect=true"} {
+		query := <-queries
+		if query != expected {
+			t.Fatalf("connection %v requested %q, expected %q", i+1, query, expected)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package twitter
+
+import (
+	"strconv"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+type TweetDraft struct {
+	Text  string
+	Media []UploadMediaConfig
+}
+
+type PostThreadConfig struct {
+	Drafts []TweetDraft
+	// InReplyToTweetID optionally attaches the thread to an existing tweet
+	InReplyToTweetID *string
+	// RollbackOnError deletes the tweets already posted if posting one of the drafts fails
+	RollbackOnError bool
+}
+
+type PostThreadResult struct {
+	// TweetIDs holds the ids of the posted tweets that remain online, in thread order
+	TweetIDs []string
+	// FailedIndex holds the index of the draft that could not be posted
+	FailedIndex *int
+	RolledBack  bool
+}
+
+// PostThread posts the drafts as a chain of replies, each tweet replying to the previous one
+func (service *Service) PostThread(config *PostThreadConfig) (*PostThreadResult, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("PostThreadConfig must not be a nil pointer")
+	}
+
+	if len(config.Drafts) == 0 {
+		return nil, errortools.ErrorMessage("No drafts specified")
+	}
+
+	e := service.requireUserContext("PostThread")
+	if e != nil {
+		return nil, e
+	}
+
+	result := PostThreadResult{
+		TweetIDs: []string{},
+	}
+
+	inReplyToTweetID := config.InReplyToTweetID
+
+	for i, draft := range config.Drafts {
+		tweetID, e := service.postThreadTweet(&draft, inReplyToTweetID)
+		if e != nil {
+			failedIndex := i
+			result.FailedIndex = &failedIndex
+
+			e.SetMessagef("Posting tweet %v of %v failed: %s", i+1, len(config.Drafts), e.Message())
+			e.SetExtra("failed_index", strconv.Itoa(failedIndex))
+
+			if config.RollbackOnError {
+				service.rollbackThread(&result)
+			}
+
+			return &result, e
+		}
+
+		result.TweetIDs = append(result.TweetIDs, tweetID)
+		inReplyToTweetID = &tweetID
+	}
+
+	return &result, nil
+}
+
+func (service *Service) postThreadTweet(draft *TweetDraft, inReplyToTweetID *string) (string, *errortools.Error) {
+	text := draft.Text
+	config := CreateTweetConfig{
+		Text: &text,
+	}
+
+	if len(draft.Media) > 0 {
+		mediaIDs := []string{}
+
+		for i := range draft.Media {
+			mediaID, e := service.UploadMedia(&draft.Media[i])
+			if e != nil {
+				return "", e
+			}
+
+			mediaIDs = append(mediaIDs, mediaID)
+		}
+
+		config.Media = &CreateTweetConfigMedia{
+			MediaIDs: mediaIDs,
+		}
+	}
+
+	if inReplyToTweetID != nil {
+		config.Reply = &CreateTweetConfigReply{
+			InReplyToTweetID: *inReplyToTweetID,
+		}
+	}
+
+	tweet, e := service.CreateTweet(&config)
+	if e != nil {
+		return "", e
+	}
+
+	return tweet.ID, nil
+}
+
+// rollbackThread deletes the posted tweets, last one first
+func (service *Service) rollbackThread(result *PostThreadResult) {
+	remaining := []string{}
+
+	for i := len(result.TweetIDs) - 1; i >= 0; i-- {
+		deleted, e := service.DeleteTweet(result.TweetIDs[i])
+		if e != nil {
+			errortools.CaptureError(e)
+		}
+
+		if !deleted {
+			remaining = append([]string{result.TweetIDs[i]}, remaining...)
+		}
+	}
+
+	result.TweetIDs = remaining
+	result.RolledBack = len(remaining) == 0
+}
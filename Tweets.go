@@ -727,3 +727,154 @@ func (call *GetTweetsCall) Do() (*[]models.Tweet, *models.Includes, *[]string, *
 
 	return &tweets, &includes, &nonExistingTweetIDs, nil
 }
+
+type ReplySettings string
+
+const (
+	ReplySettingsMentionedUsers ReplySettings = "mentionedUsers"
+	ReplySettingsFollowing      ReplySettings = "following"
+)
+
+type CreateTweetConfig struct {
+	Text                  *string                 `json:"text,omitempty"`
+	ForSuperFollowersOnly *bool                   `json:"for_super_followers_only,omitempty"`
+	Media                 *CreateTweetConfigMedia `json:"media,omitempty"`
+	Poll                  *CreateTweetConfigPoll  `json:"poll,omitempty"`
+	QuoteTweetID          *string                 `json:"quote_tweet_id,omitempty"`
+	Reply                 *CreateTweetConfigReply `json:"reply,omitempty"`
+	ReplySettings         *ReplySettings          `json:"reply_settings,omitempty"`
+}
+
+type CreateTweetConfigMedia struct {
+	MediaIDs      []string `json:"media_ids"`
+	TaggedUserIDs []string `json:"tagged_user_ids,omitempty"`
+}
+
+type CreateTweetConfigPoll struct {
+	Options         []string `json:"options"`
+	DurationMinutes int      `json:"duration_minutes"`
+}
+
+type CreateTweetConfigReply struct {
+	InReplyToTweetID    string   `json:"in_reply_to_tweet_id"`
+	ExcludeReplyUserIDs []string `json:"exclude_reply_user_ids,omitempty"`
+}
+
+type CreateTweetResponse struct {
+	Data   *models.Tweet   `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// CreateTweet posts a tweet on behalf of the authenticated user and returns its id and text
+func (service *Service) CreateTweet(config *CreateTweetConfig) (*models.Tweet, *errortools.Error) {
+	if config == nil {
+		return nil, errortools.ErrorMessage("CreateTweetConfig must not be a nil pointer")
+	}
+
+	e := service.requireUserContext("CreateTweet")
+	if e != nil {
+		return nil, e
+	}
+
+	createTweetResponse := CreateTweetResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url("tweets"),
+		BodyModel:     config,
+		ResponseModel: &createTweetResponse,
+	}
+
+	request, response, e := service.post(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if createTweetResponse.Errors != nil {
+		return nil, errorsFound(request, response, *createTweetResponse.Errors)
+	}
+
+	if createTweetResponse.Data == nil {
+		return nil, errortools.ErrorMessage("Response does not contain the created tweet")
+	}
+
+	return createTweetResponse.Data, nil
+}
+
+type DeleteTweetResponse struct {
+	Data *struct {
+		Deleted bool `json:"deleted"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// DeleteTweet deletes a tweet of the authenticated user and returns whether it was deleted
+func (service *Service) DeleteTweet(tweetID string) (bool, *errortools.Error) {
+	e := service.requireUserContext("DeleteTweet")
+	if e != nil {
+		return false, e
+	}
+
+	deleteTweetResponse := DeleteTweetResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("tweets/%s", tweetID)),
+		ResponseModel: &deleteTweetResponse,
+	}
+
+	request, response, e := service.delete(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if deleteTweetResponse.Errors != nil {
+		return false, errorsFound(request, response, *deleteTweetResponse.Errors)
+	}
+
+	if deleteTweetResponse.Data == nil {
+		return false, nil
+	}
+
+	return deleteTweetResponse.Data.Deleted, nil
+}
+
+type HideReplyResponse struct {
+	Data *struct {
+		Hidden bool `json:"hidden"`
+	} `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+// HideReply hides or unhides a reply to a conversation started by the authenticated user
+// and returns whether the reply is hidden
+func (service *Service) HideReply(tweetID string, hidden bool) (bool, *errortools.Error) {
+	e := service.requireUserContext("HideReply")
+	if e != nil {
+		return false, e
+	}
+
+	var body = struct {
+		Hidden bool `json:"hidden"`
+	}{
+		Hidden: hidden,
+	}
+
+	hideReplyResponse := HideReplyResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           service.url(fmt.Sprintf("tweets/%s/hidden", tweetID)),
+		BodyModel:     body,
+		ResponseModel: &hideReplyResponse,
+	}
+
+	request, response, e := service.put(&requestConfig)
+	if e != nil {
+		return false, e
+	}
+
+	if hideReplyResponse.Errors != nil {
+		return false, errorsFound(request, response, *hideReplyResponse.Errors)
+	}
+
+	if hideReplyResponse.Data == nil {
+		return false, errortools.ErrorMessage("Response does not contain the hidden state")
+	}
+
+	return hideReplyResponse.Data.Hidden, nil
+}
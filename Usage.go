@@ -0,0 +1,132 @@
+package twitter
+
+import (
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	models "github.com/leapforce-libraries/go_twitter_new/models"
+)
+
+type UsageField string
+
+const (
+	UsageFieldCapResetDay         UsageField = "cap_reset_day"
+	UsageFieldDailyClientAppUsage UsageField = "daily_client_app_usage"
+	UsageFieldDailyProjectUsage   UsageField = "daily_project_usage"
+	UsageFieldProjectCap          UsageField = "project_cap"
+	UsageFieldProjectID           UsageField = "project_id"
+	UsageFieldProjectUsage        UsageField = "project_usage"
+)
+
+type UsageResponse struct {
+	Data   *models.Usage   `json:"data"`
+	Errors *[]models.Error `json:"errors"`
+}
+
+func setUsageFields(add bool, usageFields *[]string, setUsageFields []UsageField) {
+	elems := []string{}
+
+	if usageFields != nil && add {
+		elems = *usageFields
+	}
+
+	for _, usageField := range setUsageFields {
+		for _, _elem := range elems {
+			if _elem == string(usageField) {
+				goto next
+			}
+		}
+		elems = append(elems, string(usageField))
+	next:
+	}
+	(*usageFields) = elems
+}
+
+type GetUsageCall struct {
+	service     *Service
+	Days        *int      `tw:"days"`
+	UsageFields *[]string `tw:"usage.fields"`
+}
+
+// NewGetUsageCall returns the tweet usage of the project, by default all usage fields are requested
+func (service *Service) NewGetUsageCall() *GetUsageCall {
+	call := GetUsageCall{
+		service: service,
+	}
+
+	return call.SetUsageFields(
+		UsageFieldCapResetDay,
+		UsageFieldDailyClientAppUsage,
+		UsageFieldDailyProjectUsage,
+		UsageFieldProjectCap,
+		UsageFieldProjectID,
+		UsageFieldProjectUsage,
+	)
+}
+
+// SetDays sets the number of days (1 to 90) covered by the daily usage breakdowns
+func (call *GetUsageCall) SetDays(days int) *GetUsageCall {
+	(*call).Days = &days
+
+	return call
+}
+
+func (call *GetUsageCall) SetUsageFields(usageFields ...UsageField) *GetUsageCall {
+	if call.UsageFields == nil {
+		call.UsageFields = &[]string{}
+	}
+	setUsageFields(false, call.UsageFields, usageFields)
+	return call
+}
+
+func (call *GetUsageCall) AddUsageFields(usageFields ...UsageField) *GetUsageCall {
+	if call.UsageFields == nil {
+		call.UsageFields = &[]string{}
+	}
+	setUsageFields(true, call.UsageFields, usageFields)
+	return call
+}
+
+func (call *GetUsageCall) Do() (*models.Usage, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, e
+	}
+
+	usageResponse := UsageResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(fmt.Sprintf("usage/tweets%s", *params)),
+		ResponseModel: &usageResponse,
+	}
+
+	endpoint := "usage_tweets"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	if usageResponse.Errors != nil {
+		return nil, errorsFound(request, response, *usageResponse.Errors)
+	}
+
+	if usageResponse.Data == nil {
+		return nil, errortools.ErrorMessage("Response does not contain usage")
+	}
+
+	return usageResponse.Data, nil
+}
+
+// GetUsage returns the tweet usage of the project, with the daily breakdowns covering the last days (1 to 90)
+func (service *Service) GetUsage(days *int) (*models.Usage, *errortools.Error) {
+	call := service.NewGetUsageCall()
+	if days != nil {
+		call.SetDays(*days)
+	}
+
+	return call.Do()
+}
@@ -9,12 +9,23 @@ import (
 	models "github.com/leapforce-libraries/go_twitter_new/models"
 )
 
+const (
+	maximumNumberOfUsersPerCall int = 100
+)
+
 type UsersResponse struct {
 	Data     *models.User     `json:"data"`
 	Includes *models.Includes `json:"includes"`
 	Errors   *[]models.Error  `json:"errors"`
 }
 
+type UserListResponse struct {
+	Data     *[]models.User   `json:"data"`
+	Includes *models.Includes `json:"includes"`
+	Meta     *models.Meta     `json:"meta"`
+	Errors   *[]models.Error  `json:"errors"`
+}
+
 type UserExpansion string
 
 const (
@@ -146,3 +157,457 @@ func (call *GetUsersCall) Do() (*models.User, *models.Includes, *errortools.Erro
 
 	return usersResponse.Data, usersResponse.Includes, nil
 }
+
+type GetUsersByIDsCall struct {
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	IDs         []string  `tw:"ids"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+// NewGetUsersByIDsCall looks up users by id, in batches of 100
+func (service *Service) NewGetUsersByIDsCall(ids []string) *GetUsersByIDsCall {
+	return &GetUsersByIDsCall{
+		service: service,
+		IDs:     ids,
+	}
+}
+
+func (call *GetUsersByIDsCall) SetExpansions(expansions ...UserExpansion) *GetUsersByIDsCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetUsersByIDsCall) SetTweetFields(tweetFields ...TweetField) *GetUsersByIDsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUsersByIDsCall) AddTweetFields(tweetFields ...TweetField) *GetUsersByIDsCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUsersByIDsCall) SetUserFields(userFields ...UserField) *GetUsersByIDsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUsersByIDsCall) AddUserFields(userFields ...UserField) *GetUsersByIDsCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUsersByIDsCall) Do() (*[]models.User, *models.Includes, *[]string, *errortools.Error) {
+	if len(call.IDs) == 0 {
+		return nil, nil, nil, errortools.ErrorMessage("No UserIDs specified")
+	}
+
+	users := []models.User{}
+	includes := models.Includes{}
+	notFound := []string{}
+
+	ids := call.IDs
+	defer func() {
+		call.IDs = ids
+	}()
+
+	remaining := ids
+	for {
+		batch := remaining
+		if len(remaining) > maximumNumberOfUsersPerCall {
+			batch = remaining[:maximumNumberOfUsersPerCall]
+		}
+
+		call.IDs = batch
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users%s", *params)
+
+		usersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &usersResponse,
+		}
+
+		endpoint := "users_by_ids"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		var errors []models.Error
+
+		if usersResponse.Errors != nil {
+			for _, userError := range *usersResponse.Errors {
+				if userError.Title == "Not Found Error" {
+					notFound = append(notFound, userError.Value)
+				} else {
+					errors = append(errors, userError)
+				}
+			}
+
+			if len(errors) > 0 {
+				errortools.CaptureError(errorsFound(request, response, errors))
+			}
+		}
+
+		if usersResponse.Data != nil {
+			users = append(users, (*usersResponse.Data)...)
+		}
+
+		includes.Append(usersResponse.Includes)
+
+		if len(remaining) <= maximumNumberOfUsersPerCall {
+			break
+		}
+
+		remaining = remaining[maximumNumberOfUsersPerCall:]
+	}
+
+	return &users, &includes, &notFound, nil
+}
+
+type GetUsersByUsernamesCall struct {
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	Usernames   []string  `tw:"usernames"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+// NewGetUsersByUsernamesCall looks up users by username, in batches of 100
+func (service *Service) NewGetUsersByUsernamesCall(usernames []string) *GetUsersByUsernamesCall {
+	return &GetUsersByUsernamesCall{
+		service:   service,
+		Usernames: usernames,
+	}
+}
+
+func (call *GetUsersByUsernamesCall) SetExpansions(expansions ...UserExpansion) *GetUsersByUsernamesCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetUsersByUsernamesCall) SetTweetFields(tweetFields ...TweetField) *GetUsersByUsernamesCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUsersByUsernamesCall) AddTweetFields(tweetFields ...TweetField) *GetUsersByUsernamesCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUsersByUsernamesCall) SetUserFields(userFields ...UserField) *GetUsersByUsernamesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUsersByUsernamesCall) AddUserFields(userFields ...UserField) *GetUsersByUsernamesCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUsersByUsernamesCall) Do() (*[]models.User, *models.Includes, *[]string, *errortools.Error) {
+	if len(call.Usernames) == 0 {
+		return nil, nil, nil, errortools.ErrorMessage("No Usernames specified")
+	}
+
+	users := []models.User{}
+	includes := models.Includes{}
+	notFound := []string{}
+
+	usernames := call.Usernames
+	defer func() {
+		call.Usernames = usernames
+	}()
+
+	remaining := usernames
+	for {
+		batch := remaining
+		if len(remaining) > maximumNumberOfUsersPerCall {
+			batch = remaining[:maximumNumberOfUsersPerCall]
+		}
+
+		call.Usernames = batch
+		params, e := call.service.urlParams(call)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		urlPath := fmt.Sprintf("users/by%s", *params)
+
+		usersResponse := UserListResponse{}
+		requestConfig := go_http.RequestConfig{
+			Url:           call.service.url(urlPath),
+			ResponseModel: &usersResponse,
+		}
+
+		endpoint := "users_by_usernames"
+		call.service.rateLimitService.Check(endpoint)
+
+		request, response, e := call.service.get(&requestConfig)
+		if e != nil {
+			return nil, nil, nil, e
+		}
+
+		call.service.rateLimitService.Set(endpoint, response)
+
+		var errors []models.Error
+
+		if usersResponse.Errors != nil {
+			for _, userError := range *usersResponse.Errors {
+				if userError.Title == "Not Found Error" {
+					notFound = append(notFound, userError.Value)
+				} else {
+					errors = append(errors, userError)
+				}
+			}
+
+			if len(errors) > 0 {
+				errortools.CaptureError(errorsFound(request, response, errors))
+			}
+		}
+
+		if usersResponse.Data != nil {
+			users = append(users, (*usersResponse.Data)...)
+		}
+
+		includes.Append(usersResponse.Includes)
+
+		if len(remaining) <= maximumNumberOfUsersPerCall {
+			break
+		}
+
+		remaining = remaining[maximumNumberOfUsersPerCall:]
+	}
+
+	return &users, &includes, &notFound, nil
+}
+
+type GetUserByUsernameCall struct {
+	service     *Service
+	username    string
+	Expansions  *[]string `tw:"expansions"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+func (service *Service) NewGetUserByUsernameCall(username string) *GetUserByUsernameCall {
+	return &GetUserByUsernameCall{
+		service:  service,
+		username: username,
+	}
+}
+
+func (call *GetUserByUsernameCall) SetExpansions(expansions ...UserExpansion) *GetUserByUsernameCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetUserByUsernameCall) SetTweetFields(tweetFields ...TweetField) *GetUserByUsernameCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUserByUsernameCall) AddTweetFields(tweetFields ...TweetField) *GetUserByUsernameCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetUserByUsernameCall) SetUserFields(userFields ...UserField) *GetUserByUsernameCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUserByUsernameCall) AddUserFields(userFields ...UserField) *GetUserByUsernameCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetUserByUsernameCall) Do() (*models.User, *models.Includes, *errortools.Error) {
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("users/by/username/%s%s", call.username, *params)
+
+	usersResponse := UsersResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &usersResponse,
+	}
+
+	endpoint := "users_by_username"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if usersResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *usersResponse.Errors))
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	return usersResponse.Data, usersResponse.Includes, nil
+}
+
+type GetMeCall struct {
+	service     *Service
+	Expansions  *[]string `tw:"expansions"`
+	TweetFields *[]string `tw:"tweet.fields"`
+	UserFields  *[]string `tw:"user.fields"`
+}
+
+// NewGetMeCall returns the authenticated user, it replaces GetAccount
+func (service *Service) NewGetMeCall() *GetMeCall {
+	return &GetMeCall{
+		service: service,
+	}
+}
+
+func (call *GetMeCall) SetExpansions(expansions ...UserExpansion) *GetMeCall {
+	elems := []string{}
+
+	for _, elem := range expansions {
+		elems = append(elems, string(elem))
+	}
+	(*call).Expansions = &elems
+
+	return call
+}
+
+func (call *GetMeCall) SetTweetFields(tweetFields ...TweetField) *GetMeCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(false, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetMeCall) AddTweetFields(tweetFields ...TweetField) *GetMeCall {
+	if call.TweetFields == nil {
+		call.TweetFields = &[]string{}
+	}
+	setTweetFields(true, call.TweetFields, tweetFields)
+	return call
+}
+
+func (call *GetMeCall) SetUserFields(userFields ...UserField) *GetMeCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(false, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetMeCall) AddUserFields(userFields ...UserField) *GetMeCall {
+	if call.UserFields == nil {
+		call.UserFields = &[]string{}
+	}
+	setUserFields(true, call.UserFields, userFields)
+	return call
+}
+
+func (call *GetMeCall) Do() (*models.User, *models.Includes, *errortools.Error) {
+	e := call.service.requireUserContext("GetMeCall")
+	if e != nil {
+		return nil, nil, e
+	}
+
+	params, e := call.service.urlParams(call)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	urlPath := fmt.Sprintf("users/me%s", *params)
+
+	usersResponse := UsersResponse{}
+	requestConfig := go_http.RequestConfig{
+		Url:           call.service.url(urlPath),
+		ResponseModel: &usersResponse,
+	}
+
+	endpoint := "users_me"
+	call.service.rateLimitService.Check(endpoint)
+
+	request, response, e := call.service.get(&requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if usersResponse.Errors != nil {
+		errortools.CaptureError(errorsFound(request, response, *usersResponse.Errors))
+	}
+
+	call.service.rateLimitService.Set(endpoint, response)
+
+	return usersResponse.Data, usersResponse.Includes, nil
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+)
+
+type ComplianceEventType string
+
+const (
+	// tweet and like compliance events
+	ComplianceEventTypeDelete   ComplianceEventType = "delete"
+	ComplianceEventTypeWithheld ComplianceEventType = "withheld"
+	ComplianceEventTypeDrop     ComplianceEventType = "drop"
+	ComplianceEventTypeUndrop   ComplianceEventType = "undrop"
+	ComplianceEventTypeScrubGeo ComplianceEventType = "scrub_geo"
+	// user compliance events
+	ComplianceEventTypeUserDelete              ComplianceEventType = "user_delete"
+	ComplianceEventTypeUserUndelete            ComplianceEventType = "user_undelete"
+	ComplianceEventTypeUserWithheld            ComplianceEventType = "user_withheld"
+	ComplianceEventTypeUserProtect             ComplianceEventType = "user_protect"
+	ComplianceEventTypeUserUnprotect           ComplianceEventType = "user_unprotect"
+	ComplianceEventTypeUserSuspend             ComplianceEventType = "user_suspend"
+	ComplianceEventTypeUserUnsuspend           ComplianceEventType = "user_unsuspend"
+	ComplianceEventTypeUserProfileModification ComplianceEventType = "user_profile_modification"
+	// label events
+	ComplianceEventTypePublicTweetNotice     ComplianceEventType = "public_tweet_notice"
+	ComplianceEventTypePublicTweetUnviewable ComplianceEventType = "public_tweet_unviewable"
+)
+
+// ComplianceEvent is an event received from one of the compliance streams,
+// which of the fields are set depends on Type
+type ComplianceEvent struct {
+	Type                ComplianceEventType `json:"-"`
+	Tweet               *ComplianceTweet    `json:"tweet"`
+	User                *ComplianceUser     `json:"user"`
+	Favorite            *ComplianceFavorite `json:"favorite"`
+	EventAt             string              `json:"event_at"`
+	WithheldInCountries []string            `json:"withheld_in_countries"`
+	UpToTweetID         *string             `json:"up_to_tweet_id"`
+	EventType           *string             `json:"event_type"`
+	Application         *string             `json:"application"`
+	Details             *string             `json:"details"`
+	ExtendedDetailsUrl  *string             `json:"extended_details_url"`
+	LabelTitle          *string             `json:"label_title"`
+}
+
+func (complianceEvent ComplianceEvent) EventAtTime() (*time.Time, error) {
+	return parseTime(complianceEvent.EventAt)
+}
+
+type ComplianceTweet struct {
+	ID       string `json:"id"`
+	AuthorID string `json:"author_id"`
+}
+
+type ComplianceUser struct {
+	ID string `json:"id"`
+}
+
+type ComplianceFavorite struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
@@ -0,0 +1,57 @@
+package models
+
+type ComplianceJobType string
+
+const (
+	ComplianceJobTypeTweets ComplianceJobType = "tweets"
+	ComplianceJobTypeUsers  ComplianceJobType = "users"
+)
+
+type ComplianceJobStatus string
+
+const (
+	ComplianceJobStatusCreated    ComplianceJobStatus = "created"
+	ComplianceJobStatusInProgress ComplianceJobStatus = "in_progress"
+	ComplianceJobStatusFailed     ComplianceJobStatus = "failed"
+	ComplianceJobStatusComplete   ComplianceJobStatus = "complete"
+	ComplianceJobStatusExpired    ComplianceJobStatus = "expired"
+)
+
+type ComplianceAction string
+
+const (
+	ComplianceActionDelete   ComplianceAction = "delete"
+	ComplianceActionScrubGeo ComplianceAction = "scrub_geo"
+)
+
+type ComplianceReason string
+
+const (
+	ComplianceReasonDeleted     ComplianceReason = "deleted"
+	ComplianceReasonSuspended   ComplianceReason = "suspended"
+	ComplianceReasonProtected   ComplianceReason = "protected"
+	ComplianceReasonDeactivated ComplianceReason = "deactivated"
+	ComplianceReasonScrubGeo    ComplianceReason = "scrub_geo"
+)
+
+type ComplianceJob struct {
+	ID                string              `json:"id"`
+	Type              ComplianceJobType   `json:"type"`
+	Name              *string             `json:"name"`
+	Resumable         bool                `json:"resumable"`
+	Status            ComplianceJobStatus `json:"status"`
+	UploadUrl         string              `json:"upload_url"`
+	UploadExpiresAt   string              `json:"upload_expires_at"`
+	DownloadUrl       string              `json:"download_url"`
+	DownloadExpiresAt string              `json:"download_expires_at"`
+	CreatedAt         string              `json:"created_at"`
+}
+
+// ComplianceJobEvent is a line of the result of a compliance job
+type ComplianceJobEvent struct {
+	ID         string           `json:"id"`
+	Action     ComplianceAction `json:"action"`
+	CreatedAt  string           `json:"created_at"`
+	RedactedAt *string          `json:"redacted_at"`
+	Reason     ComplianceReason `json:"reason"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+type DMEvent struct {
+	ID               string             `json:"id"`
+	EventType        string             `json:"event_type"`
+	Text             *string            `json:"text"`
+	CreatedAt        string             `json:"created_at"`
+	DMConversationID string             `json:"dm_conversation_id"`
+	SenderID         *string            `json:"sender_id"`
+	ParticipantIDs   []string           `json:"participant_ids"`
+	ReferencedTweets *[]ReferencedTweet `json:"referenced_tweets"`
+	Attachments      *Attachments       `json:"attachments"`
+}
+
+func (dmEvent DMEvent) CreatedAtTime() (*time.Time, error) {
+	return parseTime(dmEvent.CreatedAt)
+}
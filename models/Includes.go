@@ -6,4 +6,49 @@ type Includes struct {
 	Places *[]Place `json:"places"`
 	Media  *[]Media `json:"media"`
 	Polls  *[]Poll  `json:"polls"`
+	Topics *[]Topic `json:"topics"`
+}
+
+// Append adds the objects of other to includes
+func (includes *Includes) Append(other *Includes) {
+	if other == nil {
+		return
+	}
+
+	if other.Tweets != nil {
+		if includes.Tweets == nil {
+			includes.Tweets = &[]Tweet{}
+		}
+		(*includes.Tweets) = append(*includes.Tweets, (*other.Tweets)...)
+	}
+	if other.Users != nil {
+		if includes.Users == nil {
+			includes.Users = &[]User{}
+		}
+		(*includes.Users) = append(*includes.Users, (*other.Users)...)
+	}
+	if other.Places != nil {
+		if includes.Places == nil {
+			includes.Places = &[]Place{}
+		}
+		(*includes.Places) = append(*includes.Places, (*other.Places)...)
+	}
+	if other.Media != nil {
+		if includes.Media == nil {
+			includes.Media = &[]Media{}
+		}
+		(*includes.Media) = append(*includes.Media, (*other.Media)...)
+	}
+	if other.Polls != nil {
+		if includes.Polls == nil {
+			includes.Polls = &[]Poll{}
+		}
+		(*includes.Polls) = append(*includes.Polls, (*other.Polls)...)
+	}
+	if other.Topics != nil {
+		if includes.Topics == nil {
+			includes.Topics = &[]Topic{}
+		}
+		(*includes.Topics) = append(*includes.Topics, (*other.Topics)...)
+	}
 }
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+type List struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	CreatedAt     string `json:"created_at"`
+	Description   string `json:"description"`
+	FollowerCount int    `json:"follower_count"`
+	MemberCount   int    `json:"member_count"`
+	OwnerID       string `json:"owner_id"`
+	Private       bool   `json:"private"`
+}
+
+func (list List) CreatedAtTime() (*time.Time, error) {
+	return parseTime(list.CreatedAt)
+}
@@ -1,9 +1,10 @@
 package models
 
 type Meta struct {
-	ResultCount   int     `json:"result_count"`
-	NewestID      *string `json:"newest_id"`
-	OldestID      *string `json:"oldest_id"`
-	NextToken     *string `json:"next_token"`
-	PreviousToken *string `json:"previous_token"`
+	ResultCount     int     `json:"result_count"`
+	NewestID        *string `json:"newest_id"`
+	OldestID        *string `json:"oldest_id"`
+	NextToken       *string `json:"next_token"`
+	PreviousToken   *string `json:"previous_token"`
+	TotalTweetCount *int64  `json:"total_tweet_count"`
 }
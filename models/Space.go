@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+type Space struct {
+	ID               string   `json:"id"`
+	State            string   `json:"state"`
+	CreatedAt        string   `json:"created_at"`
+	CreatorID        string   `json:"creator_id"`
+	EndedAt          *string  `json:"ended_at"`
+	HostIDs          []string `json:"host_ids"`
+	InvitedUserIDs   []string `json:"invited_user_ids"`
+	IsTicketed       bool     `json:"is_ticketed"`
+	Language         *string  `json:"lang"`
+	ParticipantCount int      `json:"participant_count"`
+	ScheduledStart   *string  `json:"scheduled_start"`
+	SpeakerIDs       []string `json:"speaker_ids"`
+	StartedAt        *string  `json:"started_at"`
+	SubscriberCount  int      `json:"subscriber_count"`
+	Title            string   `json:"title"`
+	TopicIDs         []string `json:"topic_ids"`
+	UpdatedAt        *string  `json:"updated_at"`
+}
+
+func (space Space) CreatedAtTime() (*time.Time, error) {
+	return parseTime(space.CreatedAt)
+}
+
+type Topic struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
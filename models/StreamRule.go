@@ -0,0 +1,12 @@
+package models
+
+type StreamRule struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+	Tag   string `json:"tag"`
+}
+
+type MatchingRule struct {
+	ID  string `json:"id"`
+	Tag string `json:"tag"`
+}
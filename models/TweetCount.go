@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+type TweetCount struct {
+	Start      string `json:"start"`
+	End        string `json:"end"`
+	TweetCount int64  `json:"tweet_count"`
+}
+
+func (tweetCount TweetCount) StartTime() (*time.Time, error) {
+	return parseTime(tweetCount.Start)
+}
+
+func (tweetCount TweetCount) EndTime() (*time.Time, error) {
+	return parseTime(tweetCount.End)
+}
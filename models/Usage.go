@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// Usage holds the tweet consumption of a project, the API encodes the numbers as strings
+type Usage struct {
+	ProjectID           string                 `json:"project_id"`
+	ProjectCap          json.Number            `json:"project_cap"`
+	ProjectUsage        json.Number            `json:"project_usage"`
+	CapResetDay         int                    `json:"cap_reset_day"`
+	DailyProjectUsage   *DailyProjectUsage     `json:"daily_project_usage"`
+	DailyClientAppUsage *[]DailyClientAppUsage `json:"daily_client_app_usage"`
+}
+
+// Remaining returns the number of tweets that can still be pulled before the project cap is reached
+func (usage Usage) Remaining() (int64, error) {
+	projectCap, err := usage.ProjectCap.Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	projectUsage, err := usage.ProjectUsage.Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	if projectUsage > projectCap {
+		return 0, nil
+	}
+
+	return projectCap - projectUsage, nil
+}
+
+type DailyProjectUsage struct {
+	ProjectID string       `json:"project_id"`
+	Usage     []DailyUsage `json:"usage"`
+}
+
+type DailyClientAppUsage struct {
+	ClientAppID      string       `json:"client_app_id"`
+	Usage            []DailyUsage `json:"usage"`
+	UsageResultCount int          `json:"usage_result_count"`
+}
+
+type DailyUsage struct {
+	Date  string      `json:"date"`
+	Usage json.Number `json:"usage"`
+}